@@ -0,0 +1,56 @@
+// Command fakepokeapi serves PokeAPI responses from local fixture files, for
+// running the CLI during development without hitting the real API:
+//
+//	go run ./cmd/fakepokeapi -latency 200ms -error-rate 0.1 -error-status 429
+//	go run . -api-base-url http://localhost:8000/api/v2
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+
+	"github.com/sakuffo/pokedexcli/internal/logger"
+	"github.com/sakuffo/pokedexcli/internal/pokeapi"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:8000", "Address to listen on")
+	fixturesDir := flag.String("fixtures", "", "Directory of fixtures laid out like the bundled snapshot (defaults to the bundled snapshot)")
+	latency := flag.Duration("latency", 0, "Delay added to every response, e.g. 250ms")
+	errorRate := flag.Float64("error-rate", 0, "Fraction of requests to fail, between 0 and 1")
+	errorStatus := flag.Int("error-status", http.StatusInternalServerError, "Status code for injected failures, e.g. 404, 429 or 500")
+	errorPath := flag.String("error-path", "", "Only inject failures for request paths starting with this, e.g. pokemon/")
+	flag.Parse()
+
+	if *errorRate < 0 || *errorRate > 1 {
+		fmt.Fprintf(os.Stderr, "-error-rate must be between 0 and 1, got %v\n", *errorRate)
+		os.Exit(2)
+	}
+	if *errorStatus < 400 || *errorStatus > 599 {
+		fmt.Fprintf(os.Stderr, "-error-status must be a 4xx or 5xx code, got %d\n", *errorStatus)
+		os.Exit(2)
+	}
+
+	var fixtures fs.FS = pokeapi.Snapshot()
+	if *fixturesDir != "" {
+		fixtures = os.DirFS(*fixturesDir)
+	}
+
+	srvLogger := logger.New(logger.INFO)
+	srv, err := newServer(fixtures, srvLogger)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load fixtures: %v\n", err)
+		os.Exit(1)
+	}
+	srv.SetLatency(*latency)
+	srv.SetErrors(*errorRate, *errorStatus, *errorPath)
+
+	srvLogger.Info("Fake PokeAPI listening on http://%s%s", *addr, apiPrefix)
+	if err := http.ListenAndServe(*addr, srv); err != nil {
+		fmt.Fprintf(os.Stderr, "Server failed: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,243 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/rand"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sakuffo/pokedexcli/internal/logger"
+)
+
+const (
+	// apiPrefix is where the API is mounted, matching PokeAPI's own URLs.
+	apiPrefix = "/api/v2"
+	// defaultLimit is PokeAPI's page size when a list request has no limit.
+	defaultLimit = 20
+)
+
+// resource is one fixture file, as listed in a resource index.
+type resource struct {
+	ID   int
+	Name string
+}
+
+// server serves PokeAPI responses from fixture files laid out like the bundled
+// snapshot: /api/v2/pokemon/pikachu is pokemon/pikachu.json. Every directory of
+// fixtures is an endpoint, so new endpoints only need new files.
+type server struct {
+	fixtures fs.FS
+	// resources holds each endpoint's fixtures sorted by id, for lists and id lookups.
+	resources map[string][]resource
+	logger    *logger.Logger
+
+	latency     time.Duration
+	errorRate   float64
+	errorStatus int
+	errorPath   string
+}
+
+// newServer indexes the fixtures in fsys.
+func newServer(fsys fs.FS, lgr *logger.Logger) (*server, error) {
+	s := &server{
+		fixtures:    fsys,
+		resources:   make(map[string][]resource),
+		logger:      lgr,
+		errorStatus: http.StatusInternalServerError,
+	}
+
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		endpoint := path.Dir(name)
+		if d.IsDir() || endpoint == "." || path.Ext(name) != ".json" {
+			return nil
+		}
+
+		dat, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		var res struct {
+			ID   int    `json:"id"`
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(dat, &res); err != nil {
+			return fmt.Errorf("fixture %s: %w", name, err)
+		}
+
+		s.resources[endpoint] = append(s.resources[endpoint], resource{ID: res.ID, Name: res.Name})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for endpoint, list := range s.resources {
+		sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+		s.logger.Info("Serving %d fixtures for /%s", len(list), endpoint)
+	}
+
+	return s, nil
+}
+
+// SetLatency delays every response by d.
+func (s *server) SetLatency(d time.Duration) {
+	s.latency = d
+}
+
+// SetErrors fails a fraction of requests under pathPrefix with status.
+// An empty pathPrefix matches every request.
+func (s *server) SetErrors(rate float64, status int, pathPrefix string) {
+	s.errorRate = rate
+	s.errorStatus = status
+	s.errorPath = strings.Trim(pathPrefix, "/")
+}
+
+// underPath reports whether name is prefix or lies below it, comparing whole
+// path segments so "pokemon" doesn't match "pokemon-species".
+func underPath(name, prefix string) bool {
+	return prefix == "" || name == prefix || strings.HasPrefix(name, prefix+"/")
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.logger.Info("%s %s", r.Method, r.URL)
+
+	if !strings.HasPrefix(r.URL.Path, apiPrefix+"/") {
+		http.NotFound(w, r)
+		return
+	}
+	name := strings.Trim(strings.TrimPrefix(r.URL.Path, apiPrefix), "/")
+
+	if s.latency > 0 {
+		select {
+		case <-time.After(s.latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	if s.errorRate > 0 && underPath(name, s.errorPath) && rand.Float64() < s.errorRate {
+		s.logger.Info("Injecting %d for %s", s.errorStatus, r.URL)
+		if s.errorStatus == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "1")
+		}
+		http.Error(w, http.StatusText(s.errorStatus), s.errorStatus)
+		return
+	}
+
+	if _, ok := s.resources[name]; ok {
+		s.serveList(w, r, name)
+		return
+	}
+	s.serveResource(w, r, name)
+}
+
+// serveList writes a page of an endpoint's resources with PokeAPI-style links
+// that point back at this server.
+func (s *server) serveList(w http.ResponseWriter, r *http.Request, endpoint string) {
+	list := s.resources[endpoint]
+	offset := queryInt(r, "offset", 0)
+	limit := queryInt(r, "limit", defaultLimit)
+	if limit <= 0 {
+		// Like PokeAPI; a zero limit would link back to the same page forever
+		limit = defaultLimit
+	}
+	base := baseURL(r)
+
+	pageURL := func(offset int) *string {
+		u := fmt.Sprintf("%s/%s?offset=%d&limit=%d", base, endpoint, offset, limit)
+		return &u
+	}
+
+	type namedResource struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	}
+	page := struct {
+		Count    int             `json:"count"`
+		Next     *string         `json:"next"`
+		Previous *string         `json:"previous"`
+		Results  []namedResource `json:"results"`
+	}{
+		Count:   len(list),
+		Results: []namedResource{},
+	}
+
+	if offset+limit < len(list) {
+		page.Next = pageURL(offset + limit)
+	}
+	if offset > 0 {
+		page.Previous = pageURL(max(offset-limit, 0))
+	}
+	for _, res := range list[min(offset, len(list)):min(offset+limit, len(list))] {
+		page.Results = append(page.Results, namedResource{
+			Name: res.Name,
+			URL:  fmt.Sprintf("%s/%s/%d/", base, endpoint, res.ID),
+		})
+	}
+
+	writeJSON(w, page)
+}
+
+// serveResource writes a single fixture, looked up by name or by id.
+func (s *server) serveResource(w http.ResponseWriter, r *http.Request, name string) {
+	endpoint, key := path.Split(name)
+	endpoint = strings.TrimSuffix(endpoint, "/")
+
+	// Resources without a name, such as evolution chains, are stored by id already
+	if id, err := strconv.Atoi(key); err == nil {
+		for _, res := range s.resources[endpoint] {
+			if res.ID == id && res.Name != "" {
+				key = res.Name
+				break
+			}
+		}
+	}
+
+	dat, err := fs.ReadFile(s.fixtures, path.Join(endpoint, key)+".json")
+	if errors.Is(err, fs.ErrNotExist) {
+		// PokeAPI answers unknown resources with a plain text 404
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		s.logger.Error("Failed to read fixture for %s: %v", name, err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Write(dat)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(v)
+}
+
+// baseURL is the API root as the client reached it, so links work behind any host and port.
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host + apiPrefix
+}
+
+// queryInt reads a non-negative integer query parameter, falling back to def.
+func queryInt(r *http.Request, key string, def int) int {
+	n, err := strconv.Atoi(r.URL.Query().Get(key))
+	if err != nil || n < 0 {
+		return def
+	}
+	return n
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sakuffo/pokedexcli/internal/cache"
+	"github.com/sakuffo/pokedexcli/internal/logger"
+	"github.com/sakuffo/pokedexcli/internal/pokeapi"
+)
+
+// setupFakeAPI starts the fake server over the bundled snapshot and returns a
+// client pointed at it along with the API root URL.
+func setupFakeAPI(t *testing.T) (*server, *pokeapi.Client, string) {
+	testLogger := logger.New(logger.NONE)
+	srv, err := newServer(pokeapi.Snapshot(), testLogger)
+	if err != nil {
+		t.Fatalf("newServer failed: %v", err)
+	}
+	httpServer := httptest.NewServer(srv)
+	t.Cleanup(httpServer.Close)
+
+	testCache := cache.NewCache(5*time.Minute, testLogger)
+	t.Cleanup(func() { testCache.Close() })
+
+	apiURL := httpServer.URL + apiPrefix
+	client := pokeapi.NewClient(
+		pokeapi.WithCache(testCache),
+		pokeapi.WithLogger(testLogger),
+		pokeapi.WithBaseURL(apiURL),
+		pokeapi.WithRetryPolicy(pokeapi.RetryPolicy{}),
+	)
+	return srv, client, apiURL
+}
+
+func TestListPagination(t *testing.T) {
+	_, client, apiURL := setupFakeAPI(t)
+	ctx := context.Background()
+
+	all, err := client.ListLocations(ctx, nil)
+	if err != nil {
+		t.Fatalf("ListLocations failed: %v", err)
+	}
+	if all.Count != 3 || len(all.Results) != 3 || all.Next != nil || all.Previous != nil {
+		t.Fatalf("Unexpected single page: %+v", all)
+	}
+
+	// Walk the list one area at a time using the server's own links
+	pageURL := apiURL + "/location-area?offset=0&limit=1"
+	var names []string
+	var prev *string
+	for page := 0; ; page++ {
+		locations, err := client.ListLocations(ctx, &pageURL)
+		if err != nil {
+			t.Fatalf("ListLocations failed on page %d: %v", page, err)
+		}
+		if (page == 0) != (locations.Previous == nil) {
+			t.Errorf("Unexpected previous link on page %d: %v", page, locations.Previous)
+		}
+		for _, loc := range locations.Results {
+			names = append(names, loc.Name)
+		}
+		if locations.Next == nil {
+			prev = locations.Previous
+			break
+		}
+		pageURL = *locations.Next
+	}
+
+	want := []string{"canalave-city-area", "valley-windworks-area", "eterna-forest-area"}
+	if len(names) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, names)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("Expected areas ordered by id %v, got %v", want, names)
+			break
+		}
+	}
+	if prev == nil || *prev != apiURL+"/location-area?offset=1&limit=1" {
+		t.Errorf("Unexpected previous link on the last page: %v", prev)
+	}
+
+	// A zero limit falls back to the default page size rather than never advancing
+	zero := apiURL + "/location-area?offset=0&limit=0"
+	locations, err := client.ListLocations(ctx, &zero)
+	if err != nil {
+		t.Fatalf("ListLocations failed with limit=0: %v", err)
+	}
+	if len(locations.Results) != 3 || locations.Next != nil {
+		t.Errorf("Expected a default-sized page for limit=0, got %+v", locations)
+	}
+}
+
+func TestResourceLookup(t *testing.T) {
+	_, client, apiURL := setupFakeAPI(t)
+
+	pokemon, err := client.FetchPokemon(context.Background(), "pikachu")
+	if err != nil || pokemon.ID != 25 {
+		t.Fatalf("Expected pikachu, got %+v, %v", pokemon, err)
+	}
+
+	if _, err := client.FetchPokemon(context.Background(), "missingno"); !errors.Is(err, pokeapi.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for a missing fixture, got %v", err)
+	}
+
+	// Links in lists use ids with a trailing slash
+	resp, err := http.Get(apiURL + "/pokemon/25/")
+	if err != nil {
+		t.Fatalf("GET by id failed: %v", err)
+	}
+	defer resp.Body.Close()
+	var byID struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&byID); err != nil || byID.Name != "pikachu" {
+		t.Errorf("Expected id 25 to be pikachu, got %q, %v", byID.Name, err)
+	}
+
+	// Evolution chains have no name and are only found by id
+	chain, err := client.FetchEvolutionChain(context.Background(), 10)
+	if err != nil || chain.Chain.Species.Name != "pichu" {
+		t.Errorf("Expected the pichu chain, got %+v, %v", chain, err)
+	}
+}
+
+func TestInjectedErrors(t *testing.T) {
+	srv, client, _ := setupFakeAPI(t)
+	ctx := context.Background()
+
+	srv.SetErrors(1, http.StatusTooManyRequests, "pokemon/")
+	if _, err := client.FetchPokemon(ctx, "pikachu"); !errors.Is(err, pokeapi.ErrRateLimited) {
+		t.Errorf("Expected ErrRateLimited, got %v", err)
+	}
+	// Paths outside the prefix are unaffected
+	if _, err := client.FetchAreaPokemon(ctx, "canalave-city-area"); err != nil {
+		t.Errorf("Expected area outside the error path to succeed, got %v", err)
+	}
+	if _, err := client.FetchPokemonSpecies(ctx, "bulbasaur"); err != nil {
+		t.Errorf("Expected pokemon-species not to match the pokemon/ error path, got %v", err)
+	}
+
+	srv.SetErrors(1, http.StatusInternalServerError, "")
+	var httpErr *pokeapi.HTTPError
+	if _, err := client.FetchPokemonSpecies(ctx, "pikachu"); !errors.As(err, &httpErr) || httpErr.Status != http.StatusInternalServerError {
+		t.Errorf("Expected a 500 HTTPError, got %v", err)
+	}
+}
+
+func TestLatency(t *testing.T) {
+	srv, client, _ := setupFakeAPI(t)
+	srv.SetLatency(20 * time.Millisecond)
+
+	start := time.Now()
+	if _, err := client.FetchPokemon(context.Background(), "pikachu"); err != nil {
+		t.Fatalf("FetchPokemon failed: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("Expected the response to be delayed, took %v", elapsed)
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"io/fs"
+	"time"
+
+	"github.com/sakuffo/pokedexcli/internal/app"
+	"github.com/sakuffo/pokedexcli/internal/config"
+	"github.com/sakuffo/pokedexcli/internal/logger"
+)
+
+// defaultConfigFile is read from the working directory when -config isn't given.
+// It's optional; only a config file named with -config has to exist.
+const defaultConfigFile = "pokedexcli.json"
+
+// applyConfigFile fills opts from the config file at path. Settings given as
+// flags on the command line win over the file, which wins over flag defaults.
+func applyConfigFile(opts *app.Options, path string) error {
+	explicit := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) {
+		explicit[f.Name] = true
+	})
+
+	file, err := config.LoadFile(path)
+	if errors.Is(err, fs.ErrNotExist) && !explicit["config"] {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if file.LogLevel != "" && !explicit["loglevel"] {
+		level, err := logger.ParseLogLevel(file.LogLevel)
+		if err != nil {
+			return err
+		}
+		opts.LogLevel = level
+	}
+	if file.CacheDir != "" && !explicit["cache-dir"] {
+		opts.CacheDir = file.CacheDir
+	}
+	if file.APIBaseURL != "" && !explicit["api-base-url"] {
+		opts.APIBaseURL = file.APIBaseURL
+	}
+	if file.Timeout != 0 && !explicit["timeout"] {
+		opts.Timeout = time.Duration(file.Timeout)
+	}
+	if file.UserAgent != "" && !explicit["user-agent"] {
+		opts.UserAgent = file.UserAgent
+	}
+	if file.RateLimit != nil && !explicit["rate-limit"] {
+		opts.RateLimit = *file.RateLimit
+	}
+	if file.RateBurst != nil && !explicit["rate-burst"] {
+		opts.RateBurst = *file.RateBurst
+	}
+
+	return nil
+}
@@ -1,13 +1,16 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/sakuffo/pokedexcli/internal/cache"
 	"github.com/sakuffo/pokedexcli/internal/config"
+	"github.com/sakuffo/pokedexcli/internal/dataset"
 	"github.com/sakuffo/pokedexcli/internal/discovery"
 	"github.com/sakuffo/pokedexcli/internal/logger"
 	"github.com/sakuffo/pokedexcli/internal/party"
@@ -15,24 +18,45 @@ import (
 	"github.com/sakuffo/pokedexcli/internal/pokeapi"
 )
 
+// Options holds the settings chosen on the command line.
+type Options struct {
+	LogLevel logger.LogLevel
+	// CacheDir overrides where the on-disk HTTP cache lives, e.g. a directory shared between CI runs.
+	// When empty the cache is kept in the data directory next to the save file.
+	CacheDir string
+	// Offline serves data only from the on-disk cache and the bundled snapshot.
+	Offline bool
+	// APIBaseURL points the client at another PokeAPI-compatible server, such as cmd/fakepokeapi.
+	// When empty the public PokeAPI is used.
+	APIBaseURL string
+	// Timeout bounds each request to PokeAPI; zero uses pokeapi.DefaultTimeout.
+	Timeout time.Duration
+	// UserAgent is sent with every request; empty uses pokeapi.DefaultUserAgent.
+	UserAgent string
+	// RateLimit is the number of requests per second sent to PokeAPI, in bursts of up to RateBurst.
+	// A non-positive RateLimit disables rate limiting.
+	RateLimit float64
+	RateBurst int
+	// Dataset serves data from a dump previously loaded with ImportDataset instead of PokeAPI.
+	Dataset bool
+}
+
 // Initialize sets up all application components and returns the config.
 // It returns any error encountered rather than fatal-exiting.
-func Initialize(logLevel logger.LogLevel) (*config.Config, error) {
+func Initialize(opts Options) (*config.Config, error) {
 	// Set up logging
 	logFile, err := setupLogFile("pokedexcli.log")
 	if err != nil {
 		return nil, fmt.Errorf("failed to open log file: %w", err)
 	}
 
-	appLogger := logger.New(logLevel)
-	configureLogger(appLogger, logFile, logLevel)
+	appLogger := logger.New(opts.LogLevel)
+	configureLogger(appLogger, logFile, opts.LogLevel)
 
 	// Initialize components
-	appCache := cache.NewCache(5*time.Minute, appLogger)
-	pokeClient := pokeapi.NewClient(5*time.Second, appCache, appLogger)
-
 	persister, err := persistence.NewPersistence("pokedata.json")
 	if err != nil {
+		logFile.Close()
 		return nil, fmt.Errorf("failed to initialize persistence: %w", err)
 	}
 	persister.SetLogger(appLogger)
@@ -40,27 +64,113 @@ func Initialize(logLevel logger.LogLevel) (*config.Config, error) {
 	// Load data
 	loadedData, err := persister.Load()
 	if err != nil {
+		logFile.Close()
 		return nil, fmt.Errorf("failed to load data: %w", err)
 	}
 
+	// The dataset replaces PokeAPI entirely, so it needs no HTTP client or cache
+	var apiClient pokeapi.PokemonClient
+	var appCache cache.Store // left nil in dataset mode
+	if opts.Dataset {
+		ds, err := dataset.Load(filepath.Join(persister.Dir(), dataset.FileName))
+		if err != nil {
+			logFile.Close()
+			return nil, fmt.Errorf("failed to load dataset, run import-dataset first: %w", err)
+		}
+		appLogger.Info("Serving data from the imported dataset")
+		apiClient = dataset.NewClient(ds, appLogger)
+	} else {
+		apiClient, appCache = newHTTPClient(opts, persister.Dir(), appLogger)
+	}
+
 	// Ensure proper initialization of components
 	discoveryTracker := ensureDiscoveryTracker(loadedData.Discoveries)
 	partyManager := setupParty(loadedData.PartyMembers)
 
 	// Create application state
 	cfg := &config.Config{
-		PokeapiClient: pokeClient,
+		PokeapiClient: apiClient,
 		Persistence:   persister,
 		CaughtPokemon: loadedData.CaughtPokemon,
 		Discoveries:   discoveryTracker,
 		Logger:        appLogger,
 		Party:         partyManager,
+		Cache:         appCache,
+		LogFile:       logFile,
 	}
 
 	appLogger.Info("Application initialized successfully")
 	return cfg, nil
 }
 
+// newHTTPClient builds the PokeAPI client and its memory cache, backed by the
+// on-disk cache in dataDir unless opts.CacheDir points elsewhere.
+func newHTTPClient(opts Options, dataDir string, appLogger *logger.Logger) (*pokeapi.Client, *cache.Cache) {
+	appCache := cache.NewCache(5*time.Minute, appLogger)
+	appCache.SetLimits(cache.DefaultMaxEntries, cache.DefaultMaxBytes)
+
+	cacheDir := opts.CacheDir
+	if cacheDir == "" {
+		cacheDir = filepath.Join(dataDir, "httpcache")
+	}
+
+	diskCache, err := cache.NewDiskCache(cacheDir, cache.DefaultDiskTTL, cache.DefaultDiskMaxBytes, appLogger)
+	if err != nil {
+		// The disk tier only speeds up cold starts, so carry on without it
+		appLogger.Error("Failed to open disk cache, continuing with memory only: %v", err)
+	} else {
+		// Offline, stale data is still better than none
+		diskCache.SetServeStale(opts.Offline)
+		appCache.SetBacking(diskCache)
+	}
+
+	clientOpts := []pokeapi.Option{
+		pokeapi.WithCache(appCache),
+		pokeapi.WithLogger(appLogger),
+		pokeapi.WithRateLimit(opts.RateLimit, opts.RateBurst),
+		pokeapi.WithOffline(opts.Offline),
+	}
+	if opts.APIBaseURL != "" {
+		appLogger.Info("Using PokeAPI at %s", opts.APIBaseURL)
+		clientOpts = append(clientOpts, pokeapi.WithBaseURL(opts.APIBaseURL))
+	}
+	if opts.Timeout > 0 {
+		clientOpts = append(clientOpts, pokeapi.WithTimeout(opts.Timeout))
+	}
+	if opts.UserAgent != "" {
+		clientOpts = append(clientOpts, pokeapi.WithUserAgent(opts.UserAgent))
+	}
+	if opts.Offline {
+		appLogger.Info("Running in offline mode")
+	}
+
+	return pokeapi.NewClient(clientOpts...), appCache
+}
+
+// ImportDataset reads a PokeAPI CSV data dump from dir and stores it in the data
+// directory, where Initialize picks it up when Options.Dataset is set.
+func ImportDataset(dir string, logLevel logger.LogLevel) error {
+	appLogger := logger.New(logLevel)
+
+	persister, err := persistence.NewPersistence("pokedata.json")
+	if err != nil {
+		return fmt.Errorf("failed to initialize persistence: %w", err)
+	}
+
+	ds, err := dataset.Import(dir, appLogger)
+	if err != nil {
+		return fmt.Errorf("failed to import dataset: %w", err)
+	}
+
+	path := filepath.Join(persister.Dir(), dataset.FileName)
+	if err := ds.Save(path); err != nil {
+		return err
+	}
+
+	fmt.Printf("Imported %d pokemon and %d location areas into %s\n", len(ds.Pokemon), len(ds.Areas), path)
+	return nil
+}
+
 // SaveData saves the current application state.
 func SaveData(cfg *config.Config) error {
 	if cfg.Persistence == nil {
@@ -76,6 +186,32 @@ func SaveData(cfg *config.Config) error {
 	return cfg.Persistence.Save(dataToSave)
 }
 
+// Shutdown saves the application state and releases what Initialize opened:
+// the cache janitor, the disk cache and the log file.
+func Shutdown(cfg *config.Config) error {
+	var errs []error
+
+	if err := SaveData(cfg); err != nil {
+		errs = append(errs, fmt.Errorf("failed to save data: %w", err))
+	}
+
+	if cfg.Cache != nil {
+		if err := cfg.Cache.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close cache: %w", err))
+		}
+	}
+
+	cfg.Logger.Info("Shutdown complete")
+
+	if cfg.LogFile != nil {
+		if err := cfg.LogFile.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close log file: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 // Helper functions
 func setupLogFile(filename string) (*os.File, error) {
 	return os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
@@ -103,5 +239,9 @@ func setupParty(members []*party.PartyPokemon) *party.Party {
 	if members != nil {
 		p.Members = members
 	}
+	// Saves from older versions may lack IVs or hold stale stats
+	for _, member := range p.Members {
+		member.Migrate()
+	}
 	return p
 }
@@ -0,0 +1,332 @@
+package cache
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sakuffo/pokedexcli/internal/logger"
+)
+
+const (
+	// DefaultDiskTTL is how long responses stay valid on disk. PokeAPI data rarely changes.
+	DefaultDiskTTL = 7 * 24 * time.Hour
+	// DefaultDiskMaxBytes bounds the total size of the on-disk cache.
+	DefaultDiskMaxBytes = 256 << 20
+
+	diskEntryExt = ".entry"
+)
+
+// DiskCache stores responses as files in a directory so they survive restarts.
+// Each file starts with a one-line JSON header followed by the raw value, which lets
+// the index be rebuilt at startup without reading every value.
+type DiskCache struct {
+	dir         string
+	ttl         time.Duration
+	maxBytes    int64
+	serveStale  bool
+	index       map[string]diskEntry
+	bytes       int64
+	hits        int
+	misses      int
+	evictions   int
+	expirations int
+	mu          sync.Mutex
+	logger      *logger.Logger
+}
+
+type diskEntry struct {
+	file      string
+	size      int64 // total file size, header included
+	storedAt  time.Time
+	expiresAt time.Time
+}
+
+type diskHeader struct {
+	Key       string    `json:"key"`
+	Size      int       `json:"size"`
+	StoredAt  time.Time `json:"stored_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// NewDiskCache opens (creating if needed) a disk cache in dir. Entries expire after ttl
+// and the oldest entries are removed once the cache grows past maxBytes (0 for unbounded).
+// Corrupt files found while opening are deleted; expired ones are kept until read or
+// evicted so they can still be served stale.
+func NewDiskCache(dir string, ttl time.Duration, maxBytes int64, logger *logger.Logger) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	d := &DiskCache{
+		dir:      dir,
+		ttl:      ttl,
+		maxBytes: maxBytes,
+		index:    make(map[string]diskEntry),
+		logger:   logger,
+	}
+
+	if err := d.loadIndex(); err != nil {
+		return nil, err
+	}
+	d.evict()
+
+	logger.Info("Opened disk cache at %s with %d entries (%d bytes)", dir, len(d.index), d.bytes)
+	return d, nil
+}
+
+// SetServeStale makes Get return entries past their TTL instead of dropping them,
+// which is what offline mode wants: stale data beats no data.
+func (d *DiskCache) SetServeStale(serveStale bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.serveStale = serveStale
+}
+
+func (d *DiskCache) Add(key string, val []byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	header := diskHeader{
+		Key:       key,
+		Size:      len(val),
+		StoredAt:  now,
+		ExpiresAt: now.Add(d.ttl),
+	}
+	headerLine, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(d.dir, fileNameForKey(key))
+	tmp, err := os.CreateTemp(d.dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create cache file: %w", err)
+	}
+	// Write to a temp file and rename so readers never see a partial entry
+	_, err = tmp.Write(append(append(headerLine, '\n'), val...))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), file)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+
+	if old, ok := d.index[key]; ok {
+		d.bytes -= old.size
+	}
+	size := int64(len(headerLine) + 1 + len(val))
+	d.index[key] = diskEntry{file: file, size: size, storedAt: now, expiresAt: header.ExpiresAt}
+	d.bytes += size
+	d.logger.Debug("Stored %s on disk (%d bytes)", key, size)
+
+	d.evict()
+	return nil
+}
+
+func (d *DiskCache) Get(key string) ([]byte, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entry, ok := d.index[key]
+	if !ok {
+		d.logger.Debug("Disk cache miss: %s", key)
+		d.misses++
+		return nil, false
+	}
+
+	if !d.serveStale && time.Now().After(entry.expiresAt) {
+		d.logger.Debug("Disk cache entry expired: %s", key)
+		d.remove(key)
+		d.expirations++
+		d.misses++
+		return nil, false
+	}
+
+	header, val, err := readDiskEntry(entry.file, true)
+	if err != nil || header.Key != key {
+		d.logger.Error("Discarding corrupt disk cache entry for %s: %v", key, err)
+		d.remove(key)
+		d.misses++
+		return nil, false
+	}
+
+	d.logger.Debug("Disk cache hit: %s", key)
+	d.hits++
+	return val, true
+}
+
+// Delete removes key from the disk cache.
+func (d *DiskCache) Delete(key string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.remove(key)
+}
+
+// Keys returns every key stored on disk, sorted.
+func (d *DiskCache) Keys() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	keys := make([]string, 0, len(d.index))
+	for key := range d.index {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Stats returns the current size and activity counters of the disk cache.
+func (d *DiskCache) Stats() Stats {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return Stats{
+		Entries:     len(d.index),
+		Bytes:       d.bytes,
+		Hits:        d.hits,
+		Misses:      d.misses,
+		Evictions:   d.evictions,
+		Expirations: d.expirations,
+	}
+}
+
+// Close is a no-op; every Add is written through to disk immediately.
+func (d *DiskCache) Close() error {
+	return nil
+}
+
+// loadIndex rebuilds the in-memory index from the header of every entry file.
+func (d *DiskCache) loadIndex() error {
+	files, err := os.ReadDir(d.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read cache directory: %w", err)
+	}
+
+	for _, f := range files {
+		path := filepath.Join(d.dir, f.Name())
+		if strings.HasPrefix(f.Name(), ".tmp-") {
+			os.Remove(path) // left behind by an interrupted write
+			continue
+		}
+		if f.IsDir() || filepath.Ext(f.Name()) != diskEntryExt {
+			continue
+		}
+
+		header, _, err := readDiskEntry(path, false)
+		if err != nil {
+			d.logger.Error("Removing corrupt disk cache file %s: %v", f.Name(), err)
+			os.Remove(path)
+			continue
+		}
+		info, err := f.Info()
+		if err != nil {
+			continue
+		}
+		d.index[header.Key] = diskEntry{
+			file:      path,
+			size:      info.Size(),
+			storedAt:  header.StoredAt,
+			expiresAt: header.ExpiresAt,
+		}
+		d.bytes += info.Size()
+	}
+
+	return nil
+}
+
+// evict removes the oldest entries until the cache fits in maxBytes.
+// Callers must hold d.mu.
+func (d *DiskCache) evict() {
+	if d.maxBytes <= 0 || d.bytes <= d.maxBytes {
+		return
+	}
+
+	keys := make([]string, 0, len(d.index))
+	for key := range d.index {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return d.index[keys[i]].storedAt.Before(d.index[keys[j]].storedAt)
+	})
+
+	for _, key := range keys {
+		if d.bytes <= d.maxBytes {
+			break
+		}
+		d.logger.Debug("Evicting oldest disk cache entry: %s", key)
+		d.remove(key)
+		d.evictions++
+	}
+}
+
+// remove deletes key's file and index entry. Callers must hold d.mu.
+func (d *DiskCache) remove(key string) {
+	entry, ok := d.index[key]
+	if !ok {
+		return
+	}
+	if err := os.Remove(entry.file); err != nil && !errors.Is(err, os.ErrNotExist) {
+		d.logger.Error("Failed to remove disk cache file %s: %v", entry.file, err)
+	}
+	delete(d.index, key)
+	d.bytes -= entry.size
+}
+
+// readDiskEntry parses an entry file. The value is only read when withValue is set,
+// and is checked against the size recorded in the header.
+func readDiskEntry(path string, withValue bool) (diskHeader, []byte, error) {
+	var header diskHeader
+
+	f, err := os.Open(path)
+	if err != nil {
+		return header, nil, err
+	}
+	defer f.Close()
+
+	reader := bufio.NewReader(f)
+	line, err := reader.ReadBytes('\n')
+	if err != nil {
+		return header, nil, fmt.Errorf("missing header: %w", err)
+	}
+	if err := json.Unmarshal(bytes.TrimSpace(line), &header); err != nil {
+		return header, nil, fmt.Errorf("invalid header: %w", err)
+	}
+	if header.Key == "" {
+		return header, nil, errors.New("header has no key")
+	}
+	if !withValue {
+		return header, nil, nil
+	}
+
+	val, err := io.ReadAll(reader)
+	if err != nil {
+		return header, nil, err
+	}
+	if len(val) != header.Size {
+		return header, nil, fmt.Errorf("truncated value: got %d bytes, expected %d", len(val), header.Size)
+	}
+	return header, val, nil
+}
+
+// fileNameForKey hashes key so arbitrary URLs map to safe, fixed-length file names.
+func fileNameForKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:]) + diskEntryExt
+}
@@ -0,0 +1,170 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sakuffo/pokedexcli/internal/logger"
+)
+
+func TestDiskCacheSurvivesReopen(t *testing.T) {
+	testLogger := logger.New(logger.NONE)
+	dir := t.TempDir()
+
+	disk, err := NewDiskCache(dir, time.Hour, 0, testLogger)
+	if err != nil {
+		t.Fatalf("NewDiskCache failed: %v", err)
+	}
+	disk.Add("https://example.com", []byte("testdata"))
+
+	reopened, err := NewDiskCache(dir, time.Hour, 0, testLogger)
+	if err != nil {
+		t.Fatalf("reopening disk cache failed: %v", err)
+	}
+	val, ok := reopened.Get("https://example.com")
+	if !ok || string(val) != "testdata" {
+		t.Errorf("expected value to survive reopen, got %q (found: %v)", val, ok)
+	}
+}
+
+func TestDiskCacheCorruption(t *testing.T) {
+	testLogger := logger.New(logger.NONE)
+	dir := t.TempDir()
+
+	disk, _ := NewDiskCache(dir, time.Hour, 0, testLogger)
+	disk.Add("https://example.com/good", []byte("good"))
+	disk.Add("https://example.com/truncated", []byte("truncated"))
+
+	// Truncate one entry and drop garbage next to it
+	truncated := filepath.Join(dir, fileNameForKey("https://example.com/truncated"))
+	data, _ := os.ReadFile(truncated)
+	os.WriteFile(truncated, data[:len(data)-3], 0644)
+	os.WriteFile(filepath.Join(dir, "garbage"+diskEntryExt), []byte("not a cache entry"), 0644)
+
+	if _, ok := disk.Get("https://example.com/truncated"); ok {
+		t.Errorf("expected truncated entry to be treated as a miss")
+	}
+	if _, err := os.Stat(truncated); !os.IsNotExist(err) {
+		t.Errorf("expected truncated entry to be removed")
+	}
+
+	reopened, err := NewDiskCache(dir, time.Hour, 0, testLogger)
+	if err != nil {
+		t.Fatalf("reopening disk cache with corrupt files failed: %v", err)
+	}
+	if _, ok := reopened.Get("https://example.com/good"); !ok {
+		t.Errorf("expected intact entry to survive alongside corrupt files")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "garbage"+diskEntryExt)); !os.IsNotExist(err) {
+		t.Errorf("expected garbage file to be removed on open")
+	}
+}
+
+func TestDiskCacheExpiry(t *testing.T) {
+	testLogger := logger.New(logger.NONE)
+	disk, _ := NewDiskCache(t.TempDir(), time.Millisecond, 0, testLogger)
+	disk.Add("https://example.com", []byte("testdata"))
+
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := disk.Get("https://example.com"); ok {
+		t.Errorf("expected expired entry to be a miss")
+	}
+}
+
+func TestDiskCacheSizeLimit(t *testing.T) {
+	testLogger := logger.New(logger.NONE)
+	disk, _ := NewDiskCache(t.TempDir(), time.Hour, 400, testLogger)
+
+	for _, key := range []string{"a", "b", "c", "d"} {
+		disk.Add(key, make([]byte, 100))
+		time.Sleep(time.Millisecond) // keep insertion times distinct
+	}
+
+	if disk.bytes > 400 {
+		t.Errorf("disk cache exceeded size limit: %d bytes", disk.bytes)
+	}
+	if _, ok := disk.Get("a"); ok {
+		t.Errorf("expected oldest entry to be evicted")
+	}
+	if _, ok := disk.Get("d"); !ok {
+		t.Errorf("expected newest entry to be kept")
+	}
+}
+
+func TestCacheFallsBackToDisk(t *testing.T) {
+	testLogger := logger.New(logger.NONE)
+	dir := t.TempDir()
+
+	disk, _ := NewDiskCache(dir, time.Hour, 0, testLogger)
+	warm := NewCache(time.Minute, testLogger)
+	defer warm.Close()
+	warm.SetBacking(disk)
+	warm.Add("https://example.com", []byte("testdata"))
+
+	// A fresh memory cache over the same directory simulates a restart
+	reopened, _ := NewDiskCache(dir, time.Hour, 0, testLogger)
+	cold := NewCache(time.Minute, testLogger)
+	defer cold.Close()
+	cold.SetBacking(reopened)
+
+	val, ok := cold.Get("https://example.com")
+	if !ok || string(val) != "testdata" {
+		t.Fatalf("expected cold cache to read through to disk, got %q (found: %v)", val, ok)
+	}
+	if stats := cold.Stats(); stats.Entries != 1 {
+		t.Errorf("expected disk hit to be promoted into memory, stats: %+v", stats)
+	}
+}
+
+// blockingStore is a Store whose reads wait until release is closed.
+type blockingStore struct {
+	Store
+	reading chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingStore) Get(key string) ([]byte, bool) {
+	close(s.reading)
+	<-s.release
+	return s.Store.Get(key)
+}
+
+func TestCacheMemoryHitsDontWaitForDisk(t *testing.T) {
+	testLogger := logger.New(logger.NONE)
+	disk, _ := NewDiskCache(t.TempDir(), time.Hour, 0, testLogger)
+	slow := &blockingStore{Store: disk, reading: make(chan struct{}), release: make(chan struct{})}
+
+	c := NewCache(time.Minute, testLogger)
+	defer c.Close()
+	c.SetBacking(slow)
+	c.Add("https://example.com/hot", []byte("hot"))
+
+	// A miss blocks on the disk read...
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.Get("https://example.com/cold")
+	}()
+	<-slow.reading
+
+	// ...while a memory hit is served straight away
+	hit := make(chan []byte)
+	go func() {
+		val, _ := c.Get("https://example.com/hot")
+		hit <- val
+	}()
+	select {
+	case val := <-hit:
+		if string(val) != "hot" {
+			t.Errorf("expected memory hit, got %q", val)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("memory hit waited for the disk read")
+	}
+
+	close(slow.release)
+	<-done
+}
@@ -1,85 +1,308 @@
 package cache
 
 import (
+	"container/list"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/sakuffo/pokedexcli/internal/logger"
 )
 
+const (
+	// DefaultMaxEntries bounds the number of responses kept in memory.
+	DefaultMaxEntries = 1000
+	// DefaultMaxBytes bounds the total size of responses kept in memory.
+	DefaultMaxBytes = 64 << 20
+)
+
 type Cache struct {
-	cache           map[string]cacheEntry
+	cache           map[string]*list.Element
+	lru             *list.List // front is most recently used
 	cleanupInterval time.Duration
+	maxEntries      int
+	maxBytes        int64
+	bytes           int64
+	hits            int
+	misses          int
+	evictions       int
+	expirations     int
+	backing         Store // optional slower tier behind memory
+	done            chan struct{}
+	closeOnce       sync.Once
+	janitor         sync.WaitGroup
 	mu              sync.Mutex
 	logger          *logger.Logger
 }
 
 type cacheEntry struct {
+	key       string
 	createdAt time.Time
 	val       []byte
 }
 
+// Stats is a snapshot of a store's size and activity counters.
+type Stats struct {
+	Entries     int
+	Bytes       int64
+	Hits        int
+	Misses      int
+	Evictions   int // entries dropped to stay within the size limits
+	Expirations int // entries dropped because they outlived the TTL
+	// Backing holds the stats of the tier behind this one, if any.
+	Backing *Stats
+}
+
+// HitRate returns the fraction of lookups served by this tier, or 0 before any lookups.
+func (s Stats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
 func NewCache(interval time.Duration, logger *logger.Logger) *Cache {
 	c := &Cache{
-		cache:           make(map[string]cacheEntry),
+		cache:           make(map[string]*list.Element),
+		lru:             list.New(),
 		cleanupInterval: interval,
+		done:            make(chan struct{}),
 		logger:          logger,
 	}
 
+	c.janitor.Add(1)
 	go c.readLoop()
 
 	return c
 }
 
-func (c *Cache) Add(key string, val []byte) error {
+// SetLimits bounds the cache by entry count and total bytes, evicting the least
+// recently used entries when either is exceeded. A zero limit means unbounded.
+func (c *Cache) SetLimits(maxEntries int, maxBytes int64) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	c.maxEntries = maxEntries
+	c.maxBytes = maxBytes
+	c.evict()
+}
+
+// SetBacking layers the cache in front of another store, typically a DiskCache.
+// Misses fall through to the backing store and are promoted into memory;
+// additions are written to both tiers.
+func (c *Cache) SetBacking(backing Store) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.backing = backing
+}
+
+func (c *Cache) Add(key string, val []byte) error {
+	c.mu.Lock()
 	c.logger.Debug("Adding item to cache: %s", key)
-	c.cache[key] = cacheEntry{
-		createdAt: time.Now(),
-		val:       val,
+	c.addLocked(key, val)
+	backing := c.backing
+	c.mu.Unlock()
+
+	// Write through outside the lock so memory hits don't wait on the disk
+	if backing != nil {
+		// A disk failure shouldn't fail the request; memory still holds the value
+		if err := backing.Add(key, val); err != nil {
+			c.logger.Error("Failed to write %s to disk cache: %v", key, err)
+		}
 	}
 
 	return nil
 }
 
+// addLocked stores val in memory only. Callers must hold c.mu.
+func (c *Cache) addLocked(key string, val []byte) {
+	if elem, ok := c.cache[key]; ok {
+		c.removeElement(elem)
+	}
+
+	elem := c.lru.PushFront(&cacheEntry{
+		key:       key,
+		createdAt: time.Now(),
+		val:       val,
+	})
+	c.cache[key] = elem
+	c.bytes += int64(len(val))
+	c.evict()
+}
+
 func (c *Cache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	elem, ok := c.cache[key]
+	if ok {
+		c.logger.Debug("Cache hit: %s", key)
+		c.hits++
+		c.lru.MoveToFront(elem)
+		val := elem.Value.(*cacheEntry).val
+		c.mu.Unlock()
+		return val, true
+	}
+
+	c.logger.Debug("Cache miss: %s", key)
+	c.misses++
+	backing := c.backing
+	c.mu.Unlock()
+
+	if backing == nil {
+		return nil, false
+	}
+
+	// Read the disk without holding the lock, then promote the value
+	val, ok := backing.Get(key)
+	if !ok {
+		return nil, false
+	}
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
+	// An Add while the disk was read holds a newer value, so keep that one
+	if elem, ok := c.cache[key]; ok {
+		return elem.Value.(*cacheEntry).val, true
+	}
+	c.addLocked(key, val)
+	return val, true
+}
 
-	entry, ok := c.cache[key]
+// Delete removes key from memory and from the backing store.
+func (c *Cache) Delete(key string) {
+	c.mu.Lock()
+	if elem, ok := c.cache[key]; ok {
+		c.removeElement(elem)
+	}
+	backing := c.backing
+	c.mu.Unlock()
 
-	if ok {
-		c.logger.Debug("Cache hit: %s", key)
-	} else {
-		c.logger.Debug("Cache miss: %s", key)
+	if backing != nil {
+		backing.Delete(key)
+	}
+}
+
+// Keys returns every key held in memory or in the backing store, sorted.
+func (c *Cache) Keys() []string {
+	c.mu.Lock()
+	seen := make(map[string]bool, len(c.cache))
+	for key := range c.cache {
+		seen[key] = true
+	}
+	backing := c.backing
+	c.mu.Unlock()
+
+	if backing != nil {
+		for _, key := range backing.Keys() {
+			seen[key] = true
+		}
+	}
+
+	keys := make([]string, 0, len(seen))
+	for key := range seen {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Close stops the expiry janitor and closes the backing store, if any.
+// It waits for the janitor to exit and is safe to call more than once.
+func (c *Cache) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		close(c.done)
+		c.janitor.Wait()
+
+		c.mu.Lock()
+		backing := c.backing
+		c.mu.Unlock()
+		if backing != nil {
+			err = backing.Close()
+		}
+		c.logger.Debug("Cache closed")
+	})
+	return err
+}
+
+// Stats returns the current size and eviction counters of the cache.
+func (c *Cache) Stats() Stats {
+	c.mu.Lock()
+	stats := Stats{
+		Entries:     len(c.cache),
+		Bytes:       c.bytes,
+		Hits:        c.hits,
+		Misses:      c.misses,
+		Evictions:   c.evictions,
+		Expirations: c.expirations,
+	}
+	backing := c.backing
+	c.mu.Unlock()
+
+	// The backing store does its own locking and may touch the disk
+	if backing != nil {
+		backingStats := backing.Stats()
+		stats.Backing = &backingStats
 	}
+	return stats
+}
+
+// evict drops least recently used entries until the cache is within its limits.
+// Callers must hold c.mu.
+func (c *Cache) evict() {
+	for c.overLimit() {
+		oldest := c.lru.Back()
+		if oldest == nil {
+			return
+		}
+		c.logger.Debug("Evicting least recently used item from cache: %s", oldest.Value.(*cacheEntry).key)
+		c.removeElement(oldest)
+		c.evictions++
+	}
+}
 
-	return entry.val, ok
+func (c *Cache) overLimit() bool {
+	return (c.maxEntries > 0 && len(c.cache) > c.maxEntries) ||
+		(c.maxBytes > 0 && c.bytes > c.maxBytes)
+}
+
+// removeElement unlinks elem from the cache. Callers must hold c.mu.
+func (c *Cache) removeElement(elem *list.Element) {
+	entry := elem.Value.(*cacheEntry)
+	c.lru.Remove(elem)
+	delete(c.cache, entry.key)
+	c.bytes -= int64(len(entry.val))
 }
 
 func (c *Cache) readLoop() {
 	//Check if each item in the cache has expired
 	//Use time.Ticker to trigger the cleanup at the specified interval
 
+	defer c.janitor.Done()
+
 	ticker := time.NewTicker(c.cleanupInterval)
 	defer ticker.Stop()
 
-	for range ticker.C {
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+		}
 
 		c.mu.Lock()
 		initialSize := len(c.cache)
 		now := time.Now()
 
-		for key, entry := range c.cache {
-			if now.Sub(entry.createdAt) > c.cleanupInterval {
+		for key, elem := range c.cache {
+			if now.Sub(elem.Value.(*cacheEntry).createdAt) > c.cleanupInterval {
 				c.logger.Debug("Removing expired item from cache: %s", key)
-				delete(c.cache, key)
+				c.removeElement(elem)
 			}
 		}
 
 		itemsRemoved := initialSize - len(c.cache)
+		c.expirations += itemsRemoved
 		if itemsRemoved > 0 {
 			c.logger.Info("Removed %d items from cache", itemsRemoved)
 		}
@@ -2,6 +2,7 @@ package cache
 
 import (
 	"fmt"
+	"runtime"
 	"testing"
 	"time"
 
@@ -28,6 +29,7 @@ func TestAddGet(t *testing.T) {
 	for i, c := range cases {
 		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
 			cache := NewCache(interval, testLogger)
+			defer cache.Close()
 			cache.Add(c.key, c.val)
 			val, ok := cache.Get(c.key)
 			if !ok {
@@ -47,6 +49,7 @@ func TestReapLoop(t *testing.T) {
 	const waitTime = baseTime + 5*time.Millisecond
 	testLogger := logger.New(logger.NONE)
 	cache := NewCache(baseTime, testLogger)
+	defer cache.Close()
 	cache.Add("https://example.com", []byte("testdata"))
 
 	_, ok := cache.Get("https://example.com")
@@ -63,3 +66,109 @@ func TestReapLoop(t *testing.T) {
 		return
 	}
 }
+
+func TestLRUEvictionByCount(t *testing.T) {
+	testLogger := logger.New(logger.NONE)
+	cache := NewCache(time.Minute, testLogger)
+	defer cache.Close()
+	cache.SetLimits(2, 0)
+
+	cache.Add("a", []byte("1"))
+	cache.Add("b", []byte("2"))
+	cache.Get("a") // "b" is now least recently used
+	cache.Add("c", []byte("3"))
+
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("expected least recently used key to be evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok := cache.Get(key); !ok {
+			t.Errorf("expected to find key %s", key)
+		}
+	}
+
+	stats := cache.Stats()
+	if stats.Entries != 2 || stats.Evictions != 1 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestLRUEvictionByBytes(t *testing.T) {
+	testLogger := logger.New(logger.NONE)
+	cache := NewCache(time.Minute, testLogger)
+	defer cache.Close()
+	cache.SetLimits(0, 10)
+
+	cache.Add("a", []byte("12345"))
+	cache.Add("b", []byte("12345"))
+	cache.Add("a", []byte("123")) // replacing a key must not double count it
+	if stats := cache.Stats(); stats.Bytes != 8 || stats.Evictions != 0 {
+		t.Fatalf("unexpected stats after replace: %+v", stats)
+	}
+
+	cache.Add("c", []byte("1234"))
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("expected oldest key to be evicted to stay under the byte limit")
+	}
+	if stats := cache.Stats(); stats.Bytes > 10 {
+		t.Errorf("cache exceeded byte limit: %+v", stats)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	testLogger := logger.New(logger.NONE)
+	disk, _ := NewDiskCache(t.TempDir(), time.Hour, 0, testLogger)
+	cache := NewCache(time.Minute, testLogger)
+	defer cache.Close()
+	cache.SetBacking(disk)
+
+	cache.Add("https://example.com", []byte("testdata"))
+	cache.Delete("https://example.com")
+
+	if _, ok := cache.Get("https://example.com"); ok {
+		t.Errorf("expected deleted key to be gone from both tiers")
+	}
+	if stats := disk.Stats(); stats.Entries != 0 {
+		t.Errorf("expected disk tier to be empty, stats: %+v", stats)
+	}
+}
+
+func TestCloseStopsJanitor(t *testing.T) {
+	testLogger := logger.New(logger.NONE)
+	before := runtime.NumGoroutine()
+
+	cache := NewCache(time.Millisecond, testLogger)
+	if err := cache.Close(); err != nil {
+		t.Fatalf("Close returned an error: %v", err)
+	}
+	if err := cache.Close(); err != nil {
+		t.Fatalf("second Close returned an error: %v", err)
+	}
+
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("expected janitor goroutine to exit, goroutines before: %d, after: %d", before, after)
+	}
+}
+
+func TestStatsCountHitsAndMisses(t *testing.T) {
+	testLogger := logger.New(logger.NONE)
+	disk, _ := NewDiskCache(t.TempDir(), time.Hour, 0, testLogger)
+	cache := NewCache(time.Minute, testLogger)
+	defer cache.Close()
+	cache.SetBacking(disk)
+
+	cache.Add("https://example.com", []byte("testdata"))
+	cache.Get("https://example.com")
+	cache.Get("https://example.com/missing")
+
+	stats := cache.Stats()
+	if stats.Hits != 1 || stats.Misses != 1 {
+		t.Errorf("unexpected memory stats: %+v", stats)
+	}
+	if stats.Backing == nil || stats.Backing.Misses != 1 || stats.Backing.Entries != 1 {
+		t.Errorf("unexpected disk stats: %+v", stats.Backing)
+	}
+	if rate := stats.HitRate(); rate != 0.5 {
+		t.Errorf("expected hit rate 0.5, got %v", rate)
+	}
+}
@@ -0,0 +1,28 @@
+package cache
+
+// Store is a cache of raw API responses keyed by request URL.
+// The in-memory Cache, the on-disk DiskCache and Nop all implement it.
+type Store interface {
+	Get(key string) ([]byte, bool)
+	Add(key string, val []byte) error
+	Delete(key string)
+	Keys() []string
+	Stats() Stats
+	Close() error
+}
+
+var (
+	_ Store = (*Cache)(nil)
+	_ Store = (*DiskCache)(nil)
+	_ Store = Nop{}
+)
+
+// Nop is a Store that never holds anything, for running without a cache.
+type Nop struct{}
+
+func (Nop) Get(key string) ([]byte, bool)    { return nil, false }
+func (Nop) Add(key string, val []byte) error { return nil }
+func (Nop) Delete(key string)                {}
+func (Nop) Keys() []string                   { return nil }
+func (Nop) Stats() Stats                     { return Stats{} }
+func (Nop) Close() error                     { return nil }
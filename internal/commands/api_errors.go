@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sakuffo/pokedexcli/internal/pokeapi"
+)
+
+// describeAPIError turns a PokeAPI client error into a message fit for the user.
+// notFound is used when the requested resource does not exist.
+func describeAPIError(err error, notFound string) error {
+	var httpErr *pokeapi.HTTPError
+	switch {
+	case errors.Is(err, pokeapi.ErrNotFound):
+		return errors.New(notFound)
+	case errors.Is(err, pokeapi.ErrOffline):
+		return errors.New("not available offline, go online to fetch it")
+	case errors.Is(err, pokeapi.ErrRateLimited):
+		return errors.New("PokeAPI is rate limiting requests, try again shortly")
+	case errors.As(err, &httpErr):
+		return fmt.Errorf("PokeAPI is unavailable (status %d), try again later", httpErr.Status)
+	default:
+		return err
+	}
+}
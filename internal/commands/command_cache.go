@@ -0,0 +1,118 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sakuffo/pokedexcli/internal/cache"
+	"github.com/sakuffo/pokedexcli/internal/config"
+)
+
+func CommandCache(ctx context.Context, cfg *config.Config, args ...string) error {
+	cfg.Logger.Debug("Executing 'cache' command")
+	if cfg.Cache == nil {
+		cfg.Logger.Error("Cache command called without a cache configured")
+		return errors.New("no cache configured")
+	}
+
+	if len(args) == 0 {
+		return CommandCacheStats(cfg)
+	}
+
+	subcommand := args[0]
+
+	switch subcommand {
+	case "stats":
+		return CommandCacheStats(cfg)
+	case "list":
+		prefix := ""
+		if len(args) > 1 {
+			prefix = args[1]
+		}
+		return CommandCacheList(cfg, prefix)
+	case "clear":
+		return CommandCacheEvict(cfg, "")
+	case "evict":
+		if len(args) != 2 {
+			cfg.Logger.Error("Evict command called without a key prefix")
+			return errors.New("evict requires a key prefix")
+		}
+		return CommandCacheEvict(cfg, args[1])
+	default:
+		cfg.Logger.Error("Unknown cache subcommand: %s", subcommand)
+		return errors.New("unknown cache subcommand")
+	}
+}
+
+func CommandCacheStats(cfg *config.Config) error {
+	stats := cfg.Cache.Stats()
+	cfg.Logger.Info("Displaying cache stats: %+v", stats)
+
+	fmt.Println("Cache Stats:")
+	printCacheStats("Memory", stats)
+	if stats.Backing != nil {
+		printCacheStats("Disk", *stats.Backing)
+	}
+	return nil
+}
+
+func printCacheStats(tier string, stats cache.Stats) {
+	fmt.Printf(" - %s: %d entries | %s\n", tier, stats.Entries, formatBytes(stats.Bytes))
+	fmt.Printf("     Hits: %d | Misses: %d | Hit rate: %.1f%%\n", stats.Hits, stats.Misses, stats.HitRate()*100)
+	fmt.Printf("     Evictions: %d | Expirations: %d\n", stats.Evictions, stats.Expirations)
+}
+
+func CommandCacheList(cfg *config.Config, prefix string) error {
+	keys := matchingKeys(cfg.Cache, prefix)
+	if len(keys) == 0 {
+		cfg.Logger.Info("No cache entries found for prefix '%s'", prefix)
+		fmt.Println("No cache entries found")
+		return nil
+	}
+
+	cfg.Logger.Info("Listing %d cache entries", len(keys))
+	fmt.Printf("Cache Entries (%d):\n", len(keys))
+	for _, key := range keys {
+		fmt.Printf(" - %s\n", key)
+	}
+	return nil
+}
+
+// CommandCacheEvict removes every entry whose key starts with prefix.
+// An empty prefix clears the whole cache.
+func CommandCacheEvict(cfg *config.Config, prefix string) error {
+	keys := matchingKeys(cfg.Cache, prefix)
+	for _, key := range keys {
+		cfg.Cache.Delete(key)
+	}
+
+	cfg.Logger.Info("Evicted %d cache entries with prefix '%s'", len(keys), prefix)
+	fmt.Printf("Evicted %d cache entries\n", len(keys))
+	return nil
+}
+
+func matchingKeys(store cache.Store, prefix string) []string {
+	var keys []string
+	for _, key := range store.Keys() {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// formatBytes renders a byte count in the largest unit that keeps it above 1.
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
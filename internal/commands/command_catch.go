@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -11,7 +12,7 @@ import (
 	"github.com/sakuffo/pokedexcli/internal/pokedata"
 )
 
-func CommandCatch(cfg *config.Config, args ...string) error {
+func CommandCatch(ctx context.Context, cfg *config.Config, args ...string) error {
 	if len(args) != 1 {
 		cfg.Logger.Error("Pokemon name is required")
 		return errors.New("pokemon name is required")
@@ -20,10 +21,10 @@ func CommandCatch(cfg *config.Config, args ...string) error {
 	pokemonName := args[0]
 	cfg.Logger.Debug("Attempting to catch: %s", pokemonName)
 
-	pokemonResp, err := cfg.PokeapiClient.FetchPokemon(pokemonName)
+	pokemonResp, err := cfg.PokeapiClient.FetchPokemon(ctx, pokemonName)
 	if err != nil {
 		cfg.Logger.Error("Failed to fetch pokemon %s: %v", pokemonName, err)
-		return err
+		return describeAPIError(err, fmt.Sprintf("no such pokemon: %s", pokemonName))
 	}
 
 	res := rand.Intn(pokemonResp.BaseExperience)
@@ -42,9 +43,26 @@ func CommandCatch(cfg *config.Config, args ...string) error {
 
 	cfg.CaughtPokemon[pokemonResp.Name] = pokemonResp
 
-	err = cfg.Party.AddMember(&party.PartyPokemon{
-		BasePokemon: pokemonResp,
-	})
+	// The party that made the catch shares the experience and effort for it
+	xp := party.ExperienceYield(pokemonResp.BaseExperience, party.DefaultLevel)
+	for _, member := range cfg.Party.Members {
+		member.GainEffort(pokemonResp)
+		if err := awardExperience(ctx, cfg, member, xp); err != nil {
+			cfg.Logger.Error("Failed to award experience to %s: %v", member.Nickname, err)
+			fmt.Printf("%s couldn't gain experience: %v\n", member.Nickname, err)
+		}
+	}
+
+	caught := party.NewPartyPokemon(pokemonResp)
+	nature, err := randomNature(ctx, cfg)
+	if err != nil {
+		// A neutral nature only changes stats a little, so keep the catch
+		cfg.Logger.Error("Failed to pick a nature for %s: %v", pokemonName, err)
+	} else {
+		caught.SetNature(nature)
+	}
+
+	err = cfg.Party.AddMember(caught)
 	if err != nil {
 		cfg.Logger.Error("Failed to add %s to party: %v", pokemonName, err)
 		fmt.Printf("Failed to add %s to party: %v\n", pokemonName, err)
@@ -60,3 +78,21 @@ func CommandCatch(cfg *config.Config, args ...string) error {
 
 	return nil
 }
+
+// randomNature picks one of PokeAPI's natures at random.
+func randomNature(ctx context.Context, cfg *config.Config) (*party.Nature, error) {
+	names, err := searchIndex(ctx, cfg, "nature")
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, errors.New("no natures available")
+	}
+
+	name := names[rand.Intn(len(names))]
+	n, err := cfg.PokeapiClient.FetchNature(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return party.NatureFrom(n), nil
+}
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sakuffo/pokedexcli/internal/config"
+	"github.com/sakuffo/pokedexcli/internal/pokeapi"
+)
+
+func CommandEvolution(ctx context.Context, cfg *config.Config, args ...string) error {
+	if len(args) != 1 {
+		cfg.Logger.Error("Evolution command called without a pokemon name")
+		return errors.New("evolution requires a pokemon name")
+	}
+
+	name := strings.ToLower(args[0])
+	cfg.Logger.Info("Executing 'evolution' command for %s", name)
+
+	species, err := cfg.PokeapiClient.FetchPokemonSpecies(ctx, name)
+	if err != nil {
+		cfg.Logger.Error("Failed to fetch species %s: %v", name, err)
+		return describeAPIError(err, fmt.Sprintf("no such pokemon: %s", name))
+	}
+
+	chainID, err := species.EvolutionChain.ID()
+	if err != nil {
+		cfg.Logger.Error("Species %s has no evolution chain: %v", name, err)
+		return fmt.Errorf("%s has no evolution chain", name)
+	}
+
+	chain, err := cfg.PokeapiClient.FetchEvolutionChain(ctx, chainID)
+	if err != nil {
+		cfg.Logger.Error("Failed to fetch evolution chain %d: %v", chainID, err)
+		return describeAPIError(err, fmt.Sprintf("no evolution chain for %s", name))
+	}
+
+	fmt.Printf("Evolution chain for %s:\n", species.Name)
+	printChainLink(chain.Chain, species.Name, "", "")
+	return nil
+}
+
+// printChainLink prints link and the stages after it as a tree, highlighting current.
+// prefix draws the branch leading to link and indent the lines below it.
+func printChainLink(link pokeapi.ChainLink, current, prefix, indent string) {
+	name := link.Species.Name
+	if name == current {
+		name = colorGreen + name + colorReset
+	}
+
+	conditions := make([]string, len(link.EvolutionDetails))
+	for i, detail := range link.EvolutionDetails {
+		conditions[i] = detail.String()
+	}
+	if len(conditions) > 0 {
+		fmt.Printf("%s%s (%s)\n", prefix, name, strings.Join(conditions, " or "))
+	} else {
+		fmt.Printf("%s%s\n", prefix, name)
+	}
+
+	for i, next := range link.EvolvesTo {
+		if i == len(link.EvolvesTo)-1 {
+			printChainLink(next, current, indent+"└─ ", indent+"   ")
+		} else {
+			printChainLink(next, current, indent+"├─ ", indent+"│  ")
+		}
+	}
+}
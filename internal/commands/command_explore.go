@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -13,7 +14,7 @@ const (
 	colorReset = "\033[0m"
 )
 
-func CommandExplore(cfg *config.Config, args ...string) error {
+func CommandExplore(ctx context.Context, cfg *config.Config, args ...string) error {
 
 	// Check if area is provided
 	if len(args) != 1 {
@@ -25,10 +26,10 @@ func CommandExplore(cfg *config.Config, args ...string) error {
 
 	cfg.Logger.Info("Exploring area: %s", area)
 
-	exploreResp, err := cfg.PokeapiClient.FetchAreaPokemon(area)
+	exploreResp, err := cfg.PokeapiClient.FetchAreaPokemon(ctx, area)
 	if err != nil {
 		cfg.Logger.Error("Failed to fetch area %s: %v", area, err)
-		return err
+		return describeAPIError(err, fmt.Sprintf("no such area: %s", area))
 	}
 	locationName := exploreResp.Location.Name
 
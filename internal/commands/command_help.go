@@ -1,12 +1,13 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/sakuffo/pokedexcli/internal/config"
 )
 
-func CommandHelp(cfg *config.Config, args ...string) error {
+func CommandHelp(ctx context.Context, cfg *config.Config, args ...string) error {
 	cfg.Logger.Debug("Executing 'help' command")
 	fmt.Println()
 	fmt.Println("Welcome to the Pokedex!")
@@ -1,13 +1,14 @@
 package commands
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/sakuffo/pokedexcli/internal/config"
 )
 
-func CommandInspect(cfg *config.Config, args ...string) error {
+func CommandInspect(ctx context.Context, cfg *config.Config, args ...string) error {
 	if len(args) != 1 {
 		cfg.Logger.Error("Inspect command called without a pokemon name")
 		return errors.New("inspect requires a pokemon name")
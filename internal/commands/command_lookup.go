@@ -0,0 +1,121 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sakuffo/pokedexcli/internal/config"
+	"github.com/sakuffo/pokedexcli/internal/pokeapi"
+)
+
+// lookupKinds are the resources the lookup command can describe.
+var lookupKinds = map[string]func(context.Context, *config.Config, string) error{
+	"type":    lookupType,
+	"move":    lookupMove,
+	"ability": lookupAbility,
+}
+
+func CommandLookup(ctx context.Context, cfg *config.Config, args ...string) error {
+	if len(args) != 2 {
+		cfg.Logger.Error("Lookup called without a kind and name")
+		return errors.New("usage: lookup <type|move|ability> <name>")
+	}
+
+	kind, name := args[0], strings.ToLower(args[1])
+	lookup, ok := lookupKinds[kind]
+	if !ok {
+		cfg.Logger.Error("Unknown lookup kind: %s", kind)
+		return fmt.Errorf("can't look up %s, try type, move or ability", kind)
+	}
+
+	cfg.Logger.Info("Looking up %s %s", kind, name)
+	return lookup(ctx, cfg, name)
+}
+
+func lookupType(ctx context.Context, cfg *config.Config, name string) error {
+	t, err := cfg.PokeapiClient.FetchType(ctx, name)
+	if err != nil {
+		cfg.Logger.Error("Failed to fetch type %s: %v", name, err)
+		return describeAPIError(err, fmt.Sprintf("no such type: %s", name))
+	}
+
+	relations := t.DamageRelations
+	fmt.Printf("Type: %s\n", t.Name)
+	fmt.Println("Attacking:")
+	printRelation("super effective against", relations.DoubleDamageTo)
+	printRelation("not very effective against", relations.HalfDamageTo)
+	printRelation("no effect on", relations.NoDamageTo)
+	fmt.Println("Defending:")
+	printRelation("weak to", relations.DoubleDamageFrom)
+	printRelation("resists", relations.HalfDamageFrom)
+	printRelation("immune to", relations.NoDamageFrom)
+	fmt.Printf("Pokemon: %d, moves: %d\n", len(t.Pokemon), len(t.Moves))
+	return nil
+}
+
+func printRelation(label string, types []pokeapi.NamedAPIResource) {
+	if len(types) == 0 {
+		return
+	}
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.Name
+	}
+	fmt.Printf("  - %s: %s\n", label, strings.Join(names, ", "))
+}
+
+func lookupMove(ctx context.Context, cfg *config.Config, name string) error {
+	move, err := cfg.PokeapiClient.FetchMove(ctx, name)
+	if err != nil {
+		cfg.Logger.Error("Failed to fetch move %s: %v", name, err)
+		return describeAPIError(err, fmt.Sprintf("no such move: %s", name))
+	}
+
+	fmt.Printf("Move: %s\n", move.Name)
+	fmt.Printf("Type: %s (%s)\n", move.Type.Name, move.DamageClass.Name)
+	fmt.Printf("Power: \033[32m%s\033[0m\n", orDash(move.Power))
+	fmt.Printf("Accuracy: \033[32m%s\033[0m\n", orDash(move.Accuracy))
+	fmt.Printf("PP: \033[32m%s\033[0m\n", orDash(move.PP))
+	if move.Priority != 0 {
+		fmt.Printf("Priority: %+d\n", move.Priority)
+	}
+	if effect := move.ShortEffect(); effect != "" {
+		fmt.Printf("Effect: %s\n", effect)
+	}
+	return nil
+}
+
+func lookupAbility(ctx context.Context, cfg *config.Config, name string) error {
+	ability, err := cfg.PokeapiClient.FetchAbility(ctx, name)
+	if err != nil {
+		cfg.Logger.Error("Failed to fetch ability %s: %v", name, err)
+		return describeAPIError(err, fmt.Sprintf("no such ability: %s", name))
+	}
+
+	fmt.Printf("Ability: %s\n", ability.Name)
+	if effect, ok := pokeapi.EnglishEffect(ability.EffectEntries); ok {
+		fmt.Printf("Effect: %s\n", effect.ShortEffect)
+	}
+	if len(ability.Pokemon) > 0 {
+		fmt.Println("Pokemon:")
+		for _, p := range ability.Pokemon {
+			if p.IsHidden {
+				fmt.Printf("  - %s (hidden)\n", p.Pokemon.Name)
+			} else {
+				fmt.Printf("  - %s\n", p.Pokemon.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// orDash formats an optional stat, using "-" for moves that don't have it.
+func orDash(n *int) string {
+	if n == nil {
+		return "-"
+	}
+	return strconv.Itoa(*n)
+}
@@ -1,51 +1,111 @@
 package commands
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/sakuffo/pokedexcli/internal/config"
+	"github.com/sakuffo/pokedexcli/internal/pokeapi"
 )
 
-func CommandMapf(cfg *config.Config, args ...string) error {
+func CommandMapf(ctx context.Context, cfg *config.Config, args ...string) error {
+	if len(args) > 0 {
+		return commandMapJump(ctx, cfg, args...)
+	}
+
 	cfg.Logger.Debug("Fetching next page of locations")
-	locationsResp, err := cfg.PokeapiClient.ListLocations(cfg.NextLocationsURL)
+	locationsResp, err := locationPages(cfg).Next(ctx)
+	if errors.Is(err, pokeapi.ErrNoNextPage) {
+		cfg.Logger.Error("No next page available")
+		return errors.New("no next page")
+	}
 	if err != nil {
 		cfg.Logger.Error("Failed to fetch locations: %v", err)
 		return err
 	}
 
 	cfg.Logger.Info("Found %d locations", len(locationsResp.Results))
+	printLocations(cfg, locationsResp)
+	return nil
+}
 
-	cfg.NextLocationsURL = locationsResp.Next
-	cfg.PrevLocationsURL = locationsResp.Previous
-
-	for _, loc := range locationsResp.Results {
-		fmt.Println(loc.Name)
+func CommandMapb(ctx context.Context, cfg *config.Config, args ...string) error {
+	cfg.Logger.Debug("Fetching previous page of locations")
+	locationsResp, err := locationPages(cfg).Prev(ctx)
+	if errors.Is(err, pokeapi.ErrNoPreviousPage) {
+		cfg.Logger.Error("No previous page available")
+		return errors.New("no previous page")
+	}
+	if err != nil {
+		cfg.Logger.Error("Failed to fetch locations: %v", err)
+		return err
 	}
 
+	printLocations(cfg, locationsResp)
 	return nil
 }
 
-func CommandMapb(cfg *config.Config, args ...string) error {
-	if cfg.PrevLocationsURL == nil {
-		cfg.Logger.Error("No previous page available")
-		return errors.New("no previous page")
+// commandMapJump handles 'map page <n>', 'map size <k>', 'map first' and 'map last'.
+func commandMapJump(ctx context.Context, cfg *config.Config, args ...string) error {
+	pages := locationPages(cfg)
+
+	var locationsResp pokeapi.Locations
+	var err error
+	switch {
+	case args[0] == "first" && len(args) == 1:
+		locationsResp, err = pages.First(ctx)
+	case args[0] == "last" && len(args) == 1:
+		locationsResp, err = pages.Last(ctx)
+	case args[0] == "page" && len(args) == 2:
+		n, convErr := strconv.Atoi(args[1])
+		if convErr != nil {
+			return fmt.Errorf("invalid page number: %s", args[1])
+		}
+		locationsResp, err = pages.Page(ctx, n)
+	case args[0] == "size" && len(args) == 2:
+		size, convErr := strconv.Atoi(args[1])
+		if convErr != nil || size < 1 {
+			return fmt.Errorf("invalid page size: %s", args[1])
+		}
+		pages.SetLimit(size)
+		fmt.Printf("Showing %d locations per page\n", size)
+
+		info := pages.Info()
+		if info.Page == 0 {
+			return nil
+		}
+		// Redisplay from where the user was, at the new size
+		locationsResp, err = pages.Page(ctx, info.Page)
+	default:
+		cfg.Logger.Error("Unknown map arguments: %v", args)
+		return errors.New("usage: map [page <n> | size <k> | first | last]")
 	}
 
-	cfg.Logger.Debug("Fetching previous page of locations")
-	locationsResp, err := cfg.PokeapiClient.ListLocations(cfg.PrevLocationsURL)
 	if err != nil {
 		cfg.Logger.Error("Failed to fetch locations: %v", err)
 		return err
 	}
 
-	cfg.NextLocationsURL = locationsResp.Next
-	cfg.PrevLocationsURL = locationsResp.Previous
+	printLocations(cfg, locationsResp)
+	return nil
+}
 
-	for _, loc := range locationsResp.Results {
-		fmt.Println(loc.Name)
+// locationPages returns the paginator map and mapb share, creating it on first use.
+func locationPages(cfg *config.Config) *pokeapi.Paginator {
+	if cfg.Locations == nil {
+		cfg.Locations = pokeapi.NewPaginator(cfg.PokeapiClient, "location-area", pokeapi.DefaultPageSize)
 	}
+	return cfg.Locations
+}
 
-	return nil
+// printLocations prints a page of locations under a header saying where it is in the list.
+func printLocations(cfg *config.Config, locations pokeapi.Locations) {
+	info := locationPages(cfg).Info()
+	fmt.Printf("page %d/%d (locations %d-%d of %d)\n", info.Page, info.Pages, info.First, info.Last, info.Count)
+
+	for _, loc := range locations.Results {
+		fmt.Println(loc.Name)
+	}
 }
@@ -1,13 +1,17 @@
 package commands
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/sakuffo/pokedexcli/internal/config"
+	"github.com/sakuffo/pokedexcli/internal/party"
+	"github.com/sakuffo/pokedexcli/internal/pokedata"
 )
 
-func CommandParty(cfg *config.Config, args ...string) error {
+func CommandParty(ctx context.Context, cfg *config.Config, args ...string) error {
 	cfg.Logger.Debug("Executing 'party' command")
 	if len(args) == 0 {
 		return CommandPartyList(cfg, args...)
@@ -25,7 +29,23 @@ func CommandParty(cfg *config.Config, args ...string) error {
 		}
 		return CommandPartyInspect(cfg, args[1])
 	case "remove":
+		if len(args) != 2 {
+			cfg.Logger.Error("Remove command called without a nickname")
+			return errors.New("usage: party remove <name>")
+		}
 		return CommandPartyRemove(cfg, args[1])
+	case "evolve":
+		if len(args) != 2 {
+			cfg.Logger.Error("Evolve command called without a name")
+			return errors.New("usage: party evolve <name>")
+		}
+		return CommandPartyEvolve(ctx, cfg, args[1], "")
+	case "use":
+		if len(args) != 3 {
+			cfg.Logger.Error("Use command called without an item and a name")
+			return errors.New("usage: party use <item> <name>")
+		}
+		return CommandPartyEvolve(ctx, cfg, args[2], args[1])
 	default:
 		cfg.Logger.Error("Unknown party subcommand: %s", subcommand)
 		return errors.New("unknown party subcommand")
@@ -67,22 +87,135 @@ func CommandPartyInspect(cfg *config.Config, nickname string) error {
 	fmt.Printf("Height: \033[32m%d\033[0m\n", pokemon.BasePokemon.Height)
 	fmt.Printf("Weight: \033[32m%d\033[0m\n", pokemon.BasePokemon.Weight)
 
+	fmt.Printf("Nature: \033[32m%s\033[0m\n", describeNature(pokemon.Nature))
+
 	fmt.Printf("%s's Stats:\n", pokemon.Nickname)
-	fmt.Printf("  - HP: \033[32m%d\033[0m\n", pokemon.CurrentStats.HP)
-	fmt.Printf("  - Attack: \033[32m%d\033[0m\n", pokemon.CurrentStats.Attack)
-	fmt.Printf("  - Defense: \033[32m%d\033[0m\n", pokemon.CurrentStats.Defense)
-	fmt.Printf("  - Special Attack: \033[32m%d\033[0m\n", pokemon.CurrentStats.SpecialAttack)
-	fmt.Printf("  - Special Defense: \033[32m%d\033[0m\n", pokemon.CurrentStats.SpecialDefense)
-	fmt.Printf("  - Speed: \033[32m%d\033[0m\n", pokemon.CurrentStats.Speed)
+	for _, stat := range []struct {
+		label, name string
+	}{
+		{"HP", "hp"},
+		{"Attack", "attack"},
+		{"Defense", "defense"},
+		{"Special Attack", "special-attack"},
+		{"Special Defense", "special-defense"},
+		{"Speed", "speed"},
+	} {
+		fmt.Printf("  - %s: \033[32m%d\033[0m (IV %d, EV %d)\n", stat.label,
+			pokemon.CurrentStats.Get(stat.name), pokemon.IVs.Get(stat.name), pokemon.EVs.Get(stat.name))
+	}
 
 	fmt.Println("Types:")
 	for _, t := range pokemon.BasePokemon.Types {
 		fmt.Printf("  - %s\n", t.Type.Name)
 	}
 
+	if len(pokemon.History) > 0 {
+		fmt.Println("History:")
+		for _, event := range pokemon.History {
+			fmt.Printf("  - %s: %s from %s into %s at level %d (%s)\n",
+				event.At.Format(time.DateOnly), event.Event, event.From, event.To, event.Level, event.Reason)
+		}
+	}
+
 	return nil
 }
 
+// describeNature formats a nature with the stats it raises and lowers.
+func describeNature(nature *party.Nature) string {
+	switch {
+	case nature == nil:
+		return "neutral"
+	case nature.Increased == "":
+		return nature.Name + " (neutral)"
+	default:
+		return fmt.Sprintf("%s (+%s, -%s)", nature.Name, nature.Increased, nature.Decreased)
+	}
+}
+
+// CommandPartyEvolve evolves a party member if it qualifies, by level when item
+// is empty and by using item otherwise.
+func CommandPartyEvolve(ctx context.Context, cfg *config.Config, name, item string) error {
+	member, found := cfg.Party.GetMember(name)
+	if !found {
+		cfg.Logger.Error("Party member not found: %s", name)
+		return errors.New("party member not found")
+	}
+
+	from := member.BasePokemon.Name
+	evolved, err := evolveMember(ctx, cfg, member, item)
+	if err != nil {
+		return err
+	}
+	if !evolved {
+		if item != "" {
+			fmt.Printf("The %s had no effect on %s.\n", item, member.Nickname)
+		} else {
+			fmt.Printf("%s can't evolve yet.\n", member.Nickname)
+		}
+		return nil
+	}
+
+	printEvolution(member, from)
+
+	if err := pokedata.SaveData(cfg); err != nil {
+		cfg.Logger.Error("Failed to save data after evolving %s: %v", member.Nickname, err)
+		fmt.Printf("Failed to save data: %v\n", err)
+	}
+	return nil
+}
+
+func printEvolution(member *party.PartyPokemon, from string) {
+	fmt.Printf("What? %s is evolving!\n", member.Nickname)
+	fmt.Printf("%s evolved from %s into %s!\n", member.Nickname, from, member.BasePokemon.Name)
+}
+
+// evolveMember evolves member into the next stage of its species' evolution chain
+// when it qualifies, and reports whether it did. The evolved form is added to the
+// pokedex as well.
+func evolveMember(ctx context.Context, cfg *config.Config, member *party.PartyPokemon, item string) (bool, error) {
+	species, err := cfg.PokeapiClient.FetchPokemonSpecies(ctx, member.Species())
+	if err != nil {
+		cfg.Logger.Error("Failed to fetch species %s: %v", member.Species(), err)
+		return false, describeAPIError(err, fmt.Sprintf("no species data for %s", member.Species()))
+	}
+
+	chainID, err := species.EvolutionChain.ID()
+	if err != nil {
+		cfg.Logger.Debug("%s has no evolution chain: %v", species.Name, err)
+		return false, nil
+	}
+	chain, err := cfg.PokeapiClient.FetchEvolutionChain(ctx, chainID)
+	if err != nil {
+		cfg.Logger.Error("Failed to fetch evolution chain %d: %v", chainID, err)
+		return false, describeAPIError(err, fmt.Sprintf("no evolution chain for %s", species.Name))
+	}
+
+	next, detail, ok := member.NextEvolution(chain, item)
+	if !ok {
+		cfg.Logger.Debug("%s does not meet any evolution condition", member.Nickname)
+		return false, nil
+	}
+
+	// The chain names species; the member becomes the species' default Pokemon,
+	// whose name can differ, e.g. toxtricity-amped for toxtricity
+	nextSpecies, err := cfg.PokeapiClient.FetchPokemonSpecies(ctx, next)
+	if err != nil {
+		cfg.Logger.Error("Failed to fetch species %s: %v", next, err)
+		return false, describeAPIError(err, fmt.Sprintf("no species data for %s", next))
+	}
+	pokemonName := nextSpecies.DefaultPokemon()
+	evolved, err := cfg.PokeapiClient.FetchPokemon(ctx, pokemonName)
+	if err != nil {
+		cfg.Logger.Error("Failed to fetch pokemon %s: %v", pokemonName, err)
+		return false, describeAPIError(err, fmt.Sprintf("no such pokemon: %s", pokemonName))
+	}
+
+	cfg.Logger.Info("Evolving %s from %s into %s (%s)", member.Nickname, member.BasePokemon.Name, evolved.Name, detail)
+	member.Evolve(evolved, detail.String())
+	cfg.CaughtPokemon[evolved.Name] = evolved
+	return true, nil
+}
+
 func CommandPartyRemove(cfg *config.Config, nickname string) error {
 	cfg.Logger.Debug("Removing party member: %s", nickname)
 	fmt.Printf("Removing party member: %s\n", nickname)
@@ -1,12 +1,13 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/sakuffo/pokedexcli/internal/config"
 )
 
-func CommandPokedex(cfg *config.Config, args ...string) error {
+func CommandPokedex(ctx context.Context, cfg *config.Config, args ...string) error {
 	cfg.Logger.Info("Executing 'pokedex' command")
 
 	fmt.Println("Your Pokedex:")
@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sakuffo/pokedexcli/internal/config"
+	"github.com/sakuffo/pokedexcli/internal/pokeapi"
+	"github.com/sakuffo/pokedexcli/internal/search"
+)
+
+const (
+	// searchIndexPageSize is the page size used to fetch a whole list endpoint;
+	// bigger pages mean fewer requests for the 1000+ location areas.
+	searchIndexPageSize = 200
+	// maxSearchResults is how many matches are shown.
+	maxSearchResults = 10
+)
+
+// searchKinds maps what users search for to the list endpoint holding it.
+var searchKinds = map[string]string{
+	"location": "location-area",
+}
+
+func CommandSearch(ctx context.Context, cfg *config.Config, args ...string) error {
+	if len(args) < 2 {
+		cfg.Logger.Error("Search called without a kind and text")
+		return errors.New("usage: search location <text>")
+	}
+
+	kind, query := args[0], strings.Join(args[1:], " ")
+	endpoint, ok := searchKinds[kind]
+	if !ok {
+		cfg.Logger.Error("Unknown search kind: %s", kind)
+		return fmt.Errorf("can't search for %s, try 'search location <text>'", kind)
+	}
+
+	names, err := searchIndex(ctx, cfg, endpoint)
+	if err != nil {
+		cfg.Logger.Error("Failed to fetch the %s index: %v", endpoint, err)
+		return describeAPIError(err, fmt.Sprintf("no %s list available", kind))
+	}
+
+	matches := search.Rank(query, names)
+	cfg.Logger.Info("Search for %q matched %d of %d %ss", query, len(matches), len(names), kind)
+	if len(matches) == 0 {
+		fmt.Printf("No %ss match %q\n", kind, query)
+		return nil
+	}
+
+	fmt.Printf("%ss matching %q:\n", strings.ToUpper(kind[:1])+kind[1:], query)
+	for _, m := range matches[:min(len(matches), maxSearchResults)] {
+		fmt.Printf("  - %s\n", m.Name)
+	}
+	if len(matches) > maxSearchResults {
+		fmt.Printf("  ...and %d more\n", len(matches)-maxSearchResults)
+	}
+	return nil
+}
+
+// searchIndex returns every name in endpoint, fetching the whole list the first
+// time and keeping it for the rest of the session. The pages also land in the
+// HTTP cache, so later sessions rebuild the index without the network.
+func searchIndex(ctx context.Context, cfg *config.Config, endpoint string) ([]string, error) {
+	if names, ok := cfg.SearchIndex[endpoint]; ok {
+		return names, nil
+	}
+
+	cfg.Logger.Debug("Building search index for %s", endpoint)
+	var names []string
+	for res, err := range pokeapi.Resources(ctx, cfg.PokeapiClient, endpoint, searchIndexPageSize) {
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, res.Name)
+	}
+
+	if cfg.SearchIndex == nil {
+		cfg.SearchIndex = make(map[string][]string)
+	}
+	cfg.SearchIndex[endpoint] = names
+	return names, nil
+}
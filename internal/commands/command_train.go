@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/sakuffo/pokedexcli/internal/config"
+	"github.com/sakuffo/pokedexcli/internal/party"
+	"github.com/sakuffo/pokedexcli/internal/pokedata"
+)
+
+// maxTrainingSessions caps how many sessions one train command runs.
+const maxTrainingSessions = 10
+
+func CommandTrain(ctx context.Context, cfg *config.Config, args ...string) error {
+	if len(args) < 1 || len(args) > 2 {
+		cfg.Logger.Error("Train command called without a party member")
+		return errors.New("usage: train <name> [sessions]")
+	}
+
+	sessions := 1
+	if len(args) == 2 {
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 1 || n > maxTrainingSessions {
+			cfg.Logger.Error("Invalid number of training sessions: %s", args[1])
+			return fmt.Errorf("sessions must be a number from 1 to %d", maxTrainingSessions)
+		}
+		sessions = n
+	}
+
+	member, found := cfg.Party.GetMember(args[0])
+	if !found {
+		cfg.Logger.Error("Party member not found: %s", args[0])
+		return errors.New("party member not found")
+	}
+
+	cfg.Logger.Info("Training %s for %d sessions", member.Nickname, sessions)
+	fmt.Printf("Training %s...\n", member.Nickname)
+	for range sessions {
+		// Each session is worth a battle against a wild Pokemon like itself
+		member.GainEffort(member.BasePokemon)
+		xp := party.ExperienceYield(member.BasePokemon.BaseExperience, member.Level)
+		if err := awardExperience(ctx, cfg, member, xp); err != nil {
+			return err
+		}
+	}
+
+	if err := pokedata.SaveData(cfg); err != nil {
+		cfg.Logger.Error("Failed to save data after training %s: %v", member.Nickname, err)
+		fmt.Printf("Failed to save data: %v\n", err)
+	}
+	return nil
+}
+
+// awardExperience gives member xp using its species' growth rate, announcing any
+// level-up and evolving it if the new level allows.
+func awardExperience(ctx context.Context, cfg *config.Config, member *party.PartyPokemon, xp int) error {
+	species, err := cfg.PokeapiClient.FetchPokemonSpecies(ctx, member.Species())
+	if err != nil {
+		cfg.Logger.Error("Failed to fetch species %s: %v", member.Species(), err)
+		return describeAPIError(err, fmt.Sprintf("no species data for %s", member.Species()))
+	}
+
+	rate, err := cfg.PokeapiClient.FetchGrowthRate(ctx, species.GrowthRate.Name)
+	if err != nil {
+		cfg.Logger.Error("Failed to fetch growth rate %s: %v", species.GrowthRate.Name, err)
+		return describeAPIError(err, fmt.Sprintf("no growth rate for %s", species.Name))
+	}
+
+	gained := member.GainExperience(xp, rate)
+	cfg.Logger.Info("%s gained %d experience and %d levels", member.Nickname, xp, gained)
+	fmt.Printf("%s gained %d experience.\n", member.Nickname, xp)
+	if gained == 0 {
+		return nil
+	}
+
+	fmt.Printf("%s grew to level %d!\n", member.Nickname, member.Level)
+	from := member.BasePokemon.Name
+	evolved, err := evolveMember(ctx, cfg, member, "")
+	if err != nil {
+		return err
+	}
+	if evolved {
+		printEvolution(member, from)
+	}
+	return nil
+}
@@ -1,11 +1,15 @@
 package commands
 
-import "github.com/sakuffo/pokedexcli/internal/config"
+import (
+	"context"
+
+	"github.com/sakuffo/pokedexcli/internal/config"
+)
 
 type cliCommand struct {
 	Name        string
 	Description string
-	Callback    func(*config.Config, ...string) error
+	Callback    func(context.Context, *config.Config, ...string) error
 }
 
 func GetCommands() map[string]cliCommand {
@@ -22,12 +26,12 @@ func GetCommands() map[string]cliCommand {
 		},
 		"map": {
 			Name:        "map",
-			Description: "Lists the next 20 locations",
+			Description: "Lists the next page of locations; also 'map page <n>', 'map size <k>', 'map first' and 'map last'",
 			Callback:    CommandMapf,
 		},
 		"mapb": {
 			Name:        "mapb",
-			Description: "Lists the previous 20 locations",
+			Description: "Lists the previous page of locations",
 			Callback:    CommandMapb,
 		},
 		"explore": {
@@ -45,6 +49,16 @@ func GetCommands() map[string]cliCommand {
 			Description: "Inspects a pokemon in you have caught",
 			Callback:    CommandInspect,
 		},
+		"train": {
+			Name:        "train",
+			Description: "Trains a party member for experience, e.g. 'train pikachu 3'",
+			Callback:    CommandTrain,
+		},
+		"evolution": {
+			Name:        "evolution",
+			Description: "Shows how a pokemon evolves and what triggers each stage",
+			Callback:    CommandEvolution,
+		},
 		"pokedex": {
 			Name:        "pokedex",
 			Description: "Lists all the pokemon you have caught",
@@ -52,8 +66,23 @@ func GetCommands() map[string]cliCommand {
 		},
 		"party": {
 			Name:        "party",
-			Description: "Lists all the pokemon in your party",
+			Description: "Lists your party; also 'party inspect <name>', 'party remove <name>', 'party evolve <name>' and 'party use <item> <name>'",
 			Callback:    CommandParty,
 		},
+		"search": {
+			Name:        "search",
+			Description: "Finds locations by name, e.g. 'search location viridian'",
+			Callback:    CommandSearch,
+		},
+		"lookup": {
+			Name:        "lookup",
+			Description: "Describes a type, move or ability, e.g. 'lookup move thunderbolt'",
+			Callback:    CommandLookup,
+		},
+		"cache": {
+			Name:        "cache",
+			Description: "Shows cache stats; also 'cache list [prefix]', 'cache clear' and 'cache evict <key-prefix>'",
+			Callback:    CommandCache,
+		},
 	}
 }
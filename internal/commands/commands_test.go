@@ -2,8 +2,16 @@ package commands
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
 	"io"
+	"net/url"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,37 +24,19 @@ import (
 	"github.com/sakuffo/pokedexcli/internal/pokeapi"
 )
 
-// osExit is a variable holding os.Exit, allowing it to be mocked for tests.
-var osExit = os.Exit
-
-// mockExit is used to prevent os.Exit(0) during tests
-var mockExit func(code int)
-
-func TestMain(m *testing.M) {
-	// Override os.Exit for testing
-	originalExit := osExit
-	mockExit = func(code int) {
-		// Do nothing or maybe record the exit code
-		// fmt.Printf("os.Exit(%d) called\n", code)
-	}
-	osExit = mockExit // Replace the global function pointer
-
-	// Run tests
-	code := m.Run()
-
-	// Restore original os.Exit
-	osExit = originalExit
-	os.Exit(code)
-}
+// record makes setupReplayConfig fetch from PokeAPI and overwrite the fixtures in testdata.
+var record = flag.Bool("record", false, "record PokeAPI responses into testdata/pokeapi instead of replaying them")
 
 // setupTestConfig creates a minimal config for testing commands.
-func setupTestConfig() *config.Config {
+func setupTestConfig(t *testing.T) *config.Config {
 	testLogger := logger.New(logger.NONE) // Use NONE level for tests
 	testCache := cache.NewCache(5*time.Minute, testLogger)
-	testClient := pokeapi.NewClient(1*time.Second, testCache, testLogger)
+	t.Cleanup(func() { testCache.Close() })
+	testClient := pokeapi.NewClient(pokeapi.WithTimeout(time.Second), pokeapi.WithCache(testCache), pokeapi.WithLogger(testLogger))
 	testPersistence, _ := persistence.NewPersistence(".test_pokedata.json") // Use a test file
 	testPersistence.SetLogger(testLogger)
-	defer os.Remove(".test_pokedata.json") // Clean up test file
+	// Persistence writes into its data directory, so clean up there
+	t.Cleanup(func() { os.Remove(filepath.Join(".pokedexclidata", ".test_pokedata.json")) })
 
 	cfg := &config.Config{
 		Logger:        testLogger,
@@ -57,19 +47,55 @@ func setupTestConfig() *config.Config {
 		Party: &party.Party{
 			Members: make([]*party.PartyPokemon, 0),
 		},
+		Cache: testCache,
+	}
+	return cfg
+}
+
+// setupReplayConfig is setupTestConfig with a client that replays the PokeAPI
+// responses in testdata/pokeapi. Run the tests with -record to refresh them.
+func setupReplayConfig(t *testing.T) *config.Config {
+	cfg := setupTestConfig(t)
+
+	replayOpts := []pokeapi.Option{
+		pokeapi.WithCache(cfg.Cache),
+		pokeapi.WithLogger(cfg.Logger),
+		pokeapi.WithTransport(pokeapi.NewRecorder(filepath.Join("testdata", "pokeapi"), *record)),
+	}
+	if !*record {
+		// Replays are local, there's nothing to protect
+		replayOpts = append(replayOpts, pokeapi.WithRateLimit(0, 0))
 	}
+
+	cfg.PokeapiClient = pokeapi.NewClient(replayOpts...)
+
+	// catch saves, so keep its writes out of the shared test save file
+	useScratchPersistence(t, cfg, ".test_replay_pokedata.json")
+
 	return cfg
 }
 
+// useScratchPersistence points cfg at a save file that is removed after the test,
+// for commands that save.
+func useScratchPersistence(t *testing.T, cfg *config.Config, fileName string) {
+	scratch, err := persistence.NewPersistence(fileName)
+	if err != nil {
+		t.Fatalf("Failed to set up persistence: %v", err)
+	}
+	scratch.SetLogger(cfg.Logger)
+	t.Cleanup(func() { os.Remove(filepath.Join(".pokedexclidata", fileName)) })
+	cfg.Persistence = scratch
+}
+
 func TestCommandHelp(t *testing.T) {
-	cfg := setupTestConfig()
+	cfg := setupTestConfig(t)
 
 	// Redirect stdout to capture output
 	oldStdout := os.Stdout
 	r, w, _ := os.Pipe()
 	os.Stdout = w
 
-	err := CommandHelp(cfg)
+	err := CommandHelp(context.Background(), cfg)
 
 	// Restore stdout
 	w.Close()
@@ -93,42 +119,741 @@ func TestCommandHelp(t *testing.T) {
 }
 
 func TestCommandExit(t *testing.T) {
-	cfg := setupTestConfig()
+	cfg := setupTestConfig(t)
+
+	if err := CommandExit(context.Background(), cfg); err != nil {
+		t.Fatalf("CommandExit returned an unexpected error: %v", err)
+	}
+
+	// Saving is left to app.Shutdown, so exit must not write the save file itself
+	savePath := filepath.Join(".pokedexclidata", ".test_pokedata.json")
+	if _, err := os.Stat(savePath); !os.IsNotExist(err) {
+		t.Errorf("Expected exit not to write '%s', got %v", savePath, err)
+	}
+}
+
+func TestCommandCacheEvict(t *testing.T) {
+	cfg := setupTestConfig(t)
+	cfg.Cache.Add("https://pokeapi.co/api/v2/pokemon/pikachu", []byte("{}"))
+	cfg.Cache.Add("https://pokeapi.co/api/v2/pokemon/bulbasaur", []byte("{}"))
+	cfg.Cache.Add("https://pokeapi.co/api/v2/location-area/", []byte("{}"))
+
+	err := CommandCache(context.Background(), cfg, "evict", "https://pokeapi.co/api/v2/pokemon/")
+	if err != nil {
+		t.Fatalf("CommandCache evict returned an unexpected error: %v", err)
+	}
+	if keys := cfg.Cache.Keys(); len(keys) != 1 || keys[0] != "https://pokeapi.co/api/v2/location-area/" {
+		t.Errorf("Expected only the location entry to remain, got %v", keys)
+	}
+
+	if err := CommandCache(context.Background(), cfg, "clear"); err != nil {
+		t.Fatalf("CommandCache clear returned an unexpected error: %v", err)
+	}
+	if keys := cfg.Cache.Keys(); len(keys) != 0 {
+		t.Errorf("Expected cache to be empty after clear, got %v", keys)
+	}
+
+	if err := CommandCache(context.Background(), cfg, "evict"); err == nil {
+		t.Errorf("Expected error when evict is called without a prefix")
+	}
+}
+
+// fakeClient is a PokemonClient serving canned responses, for testing commands
+// without HTTP. Anything it doesn't know about is reported as not found.
+type fakeClient struct {
+	lists     map[string][]string // resource names by list endpoint
+	areas     map[string]pokeapi.Area
+	pokemon   map[string]pokeapi.Pokemon
+	species   map[string]pokeapi.PokemonSpecies
+	types     map[string]pokeapi.Type
+	moves     map[string]pokeapi.Move
+	abilities map[string]pokeapi.Ability
+	chains    map[int]pokeapi.EvolutionChain
+	rates     map[string]pokeapi.GrowthRate
+	natures   map[string]pokeapi.Nature
+	// err, when set, is returned by every call
+	err error
+
+	listCalls int
+}
+
+var _ pokeapi.PokemonClient = (*fakeClient)(nil)
 
-	// We use mockExit (setup in TestMain) to prevent actual exit
-	exitCalled := false
-	mockExit = func(code int) {
-		if code != 0 {
-			t.Errorf("Expected exit code 0, got %d", code)
+func (f *fakeClient) ListLocations(ctx context.Context, pageURL *string) (pokeapi.Locations, error) {
+	offset, limit := 0, pokeapi.DefaultPageSize
+	if pageURL != nil {
+		parsed, err := url.Parse(*pageURL)
+		if err != nil {
+			return pokeapi.Locations{}, err
 		}
-		exitCalled = true
+		offset, _ = strconv.Atoi(parsed.Query().Get("offset"))
+		limit, _ = strconv.Atoi(parsed.Query().Get("limit"))
+	}
+	return f.ListResources(ctx, "location-area", offset, limit)
+}
+
+func (f *fakeClient) ListResources(ctx context.Context, endpoint string, offset, limit int) (pokeapi.NamedAPIResourceList, error) {
+	f.listCalls++
+	names, err := lookupFake(f, f.lists, endpoint)
+	if err != nil {
+		return pokeapi.NamedAPIResourceList{}, err
 	}
-	osExit = mockExit                   // Make sure the mock is set for this test run too
-	defer func() { osExit = os.Exit }() // Restore original exit func after test
 
-	err := CommandExit(cfg)
+	page := pokeapi.NamedAPIResourceList{Count: len(names)}
+	end := min(offset+limit, len(names))
+	for _, name := range names[min(offset, end):end] {
+		page.Results = append(page.Results, pokeapi.NamedAPIResource{Name: name})
+	}
+	if end < len(names) {
+		next := fmt.Sprintf("%s?offset=%d&limit=%d", endpoint, end, limit)
+		page.Next = &next
+	}
+	if offset > 0 {
+		prev := fmt.Sprintf("%s?offset=%d&limit=%d", endpoint, max(offset-limit, 0), limit)
+		page.Previous = &prev
+	}
+	return page, nil
+}
+
+func (f *fakeClient) FetchAreaPokemon(ctx context.Context, area string) (pokeapi.Area, error) {
+	return lookupFake(f, f.areas, area)
+}
+
+func (f *fakeClient) FetchPokemon(ctx context.Context, pokemonName string) (pokeapi.Pokemon, error) {
+	return lookupFake(f, f.pokemon, pokemonName)
+}
+
+func (f *fakeClient) FetchPokemonSpecies(ctx context.Context, pokemonSpeciesName string) (pokeapi.PokemonSpecies, error) {
+	return lookupFake(f, f.species, pokemonSpeciesName)
+}
+
+func (f *fakeClient) FetchEvolutionChain(ctx context.Context, id int) (pokeapi.EvolutionChain, error) {
+	return lookupFake(f, f.chains, id)
+}
+
+func (f *fakeClient) FetchGrowthRate(ctx context.Context, name string) (pokeapi.GrowthRate, error) {
+	return lookupFake(f, f.rates, name)
+}
+
+func (f *fakeClient) FetchNature(ctx context.Context, name string) (pokeapi.Nature, error) {
+	return lookupFake(f, f.natures, name)
+}
+
+func (f *fakeClient) FetchType(ctx context.Context, name string) (pokeapi.Type, error) {
+	return lookupFake(f, f.types, name)
+}
+
+func (f *fakeClient) FetchMove(ctx context.Context, name string) (pokeapi.Move, error) {
+	return lookupFake(f, f.moves, name)
+}
+
+func (f *fakeClient) FetchAbility(ctx context.Context, name string) (pokeapi.Ability, error) {
+	return lookupFake(f, f.abilities, name)
+}
+
+func lookupFake[K comparable, T any](f *fakeClient, resources map[K]T, key K) (T, error) {
+	var zero T
+	if f.err != nil {
+		return zero, f.err
+	}
+	resource, ok := resources[key]
+	if !ok {
+		return zero, fmt.Errorf("%v: %w", key, pokeapi.ErrNotFound)
+	}
+	return resource, nil
+}
 
+// fromJSON builds a PokeAPI type from its JSON form, which is shorter than
+// spelling out the anonymous structs the types use.
+func fromJSON[T any](t *testing.T, data string) T {
+	t.Helper()
+	var v T
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		t.Fatalf("Invalid test JSON: %v", err)
+	}
+	return v
+}
+
+// setupFakeConfig is setupTestConfig with a fakeClient.
+func setupFakeConfig(t *testing.T, fake *fakeClient) *config.Config {
+	cfg := setupTestConfig(t)
+	cfg.PokeapiClient = fake
+	return cfg
+}
+
+func TestCommandMapPagingWithFake(t *testing.T) {
+	var areas []string
+	for i := 1; i <= pokeapi.DefaultPageSize+5; i++ {
+		areas = append(areas, fmt.Sprintf("area-%d", i))
+	}
+	cfg := setupFakeConfig(t, &fakeClient{lists: map[string][]string{"location-area": areas}})
+	ctx := context.Background()
+
+	if _, err := runCaptured(t, func() error { return CommandMapf(ctx, cfg) }); err != nil {
+		t.Fatalf("First map failed: %v", err)
+	}
+	output, err := runCaptured(t, func() error { return CommandMapf(ctx, cfg) })
+	if err != nil || !strings.HasPrefix(output, "page 2/2 (locations 21-25 of 25)\narea-21\n") || strings.Count(output, "\n") != 6 {
+		t.Fatalf("Expected the last 5 areas on the second page, got %v:\n%s", err, output)
+	}
+	if _, err := runCaptured(t, func() error { return CommandMapf(ctx, cfg) }); err == nil {
+		t.Errorf("Expected map past the last page to fail")
+	}
+
+	output, err = runCaptured(t, func() error { return CommandMapb(ctx, cfg) })
+	if err != nil || !strings.HasPrefix(output, "page 1/2 (locations 1-20 of 25)\narea-1\narea-2\n") || strings.Count(output, "\n") != 21 {
+		t.Errorf("Expected mapb to go back to the first page, got %v:\n%s", err, output)
+	}
+}
+
+func TestCommandMapJumpWithFake(t *testing.T) {
+	var areas []string
+	for i := 1; i <= 45; i++ {
+		areas = append(areas, fmt.Sprintf("area-%d", i))
+	}
+	cfg := setupFakeConfig(t, &fakeClient{lists: map[string][]string{"location-area": areas}})
+	ctx := context.Background()
+
+	tests := []struct {
+		args   []string
+		header string
+	}{
+		{[]string{"last"}, "page 3/3 (locations 41-45 of 45)"},
+		{[]string{"page", "2"}, "page 2/3 (locations 21-40 of 45)"},
+		// Shrinking the page keeps the first location shown in view
+		{[]string{"size", "10"}, "page 3/5 (locations 21-30 of 45)"},
+		{[]string{"first"}, "page 1/5 (locations 1-10 of 45)"},
+	}
+	for _, tt := range tests {
+		output, err := runCaptured(t, func() error { return CommandMapf(ctx, cfg, tt.args...) })
+		if err != nil {
+			t.Fatalf("map %v failed: %v", tt.args, err)
+		}
+		if !strings.Contains(output, tt.header+"\n") {
+			t.Errorf("map %v: expected header %q, got:\n%s", tt.args, tt.header, output)
+		}
+	}
+
+	output, err := runCaptured(t, func() error { return CommandMapf(ctx, cfg) })
+	if err != nil || !strings.HasPrefix(output, "page 2/5 (locations 11-20 of 45)\narea-11\n") {
+		t.Errorf("Expected map to continue after the jump, got %v:\n%s", err, output)
+	}
+
+	for _, args := range [][]string{{"page", "6"}, {"page", "0"}, {"page", "two"}, {"size", "0"}, {"sideways"}} {
+		if _, err := runCaptured(t, func() error { return CommandMapf(ctx, cfg, args...) }); err == nil {
+			t.Errorf("Expected map %v to fail", args)
+		}
+	}
+}
+
+func TestCommandSearch(t *testing.T) {
+	fake := &fakeClient{lists: map[string][]string{"location-area": {
+		"pallet-town-area",
+		"viridian-city-area",
+		"viridian-forest-area",
+		"route-2-south-towards-viridian-city",
+		"pewter-city-area",
+	}}}
+	cfg := setupFakeConfig(t, fake)
+	ctx := context.Background()
+
+	output, err := runCaptured(t, func() error { return CommandSearch(ctx, cfg, "location", "viridian") })
 	if err != nil {
-		// CommandExit itself should return nil, even if saving fails (it logs the error).
-		// The os.Exit(0) should happen regardless.
-		t.Fatalf("CommandExit returned an unexpected error: %v", err)
+		t.Fatalf("CommandSearch failed: %v", err)
+	}
+	want := "Locations matching \"viridian\":\n  - viridian-city-area\n  - viridian-forest-area\n  - route-2-south-towards-viridian-city\n"
+	if output != want {
+		t.Errorf("Unexpected search output:\n%s", output)
+	}
+
+	// The index is fetched once per session
+	calls := fake.listCalls
+	output, err = runCaptured(t, func() error { return CommandSearch(ctx, cfg, "location", "virdian", "forest") })
+	if err != nil || !strings.Contains(output, "viridian-forest-area") {
+		t.Errorf("Expected a fuzzy match for a typo, got %v:\n%s", err, output)
+	}
+	if fake.listCalls != calls {
+		t.Errorf("Expected the cached index to be reused, got %d more list calls", fake.listCalls-calls)
+	}
+
+	output, err = runCaptured(t, func() error { return CommandSearch(ctx, cfg, "location", "lavender") })
+	if err != nil || !strings.Contains(output, "No locations match") {
+		t.Errorf("Expected no matches, got %v:\n%s", err, output)
+	}
+
+	if err := CommandSearch(ctx, cfg, "berry", "oran"); err == nil {
+		t.Errorf("Expected an unknown search kind to fail")
+	}
+	if err := CommandSearch(ctx, cfg, "location"); err == nil {
+		t.Errorf("Expected search without text to fail")
+	}
+}
+
+func TestCommandsReportAPIErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"offline", &pokeapi.OfflineError{URL: "pokemon/pikachu"}, "not available offline"},
+		{"rate limited", &pokeapi.HTTPError{Status: 429}, "rate limiting"},
+		{"server error", &pokeapi.HTTPError{Status: 503}, "PokeAPI is unavailable (status 503)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := setupFakeConfig(t, &fakeClient{err: tt.err})
+
+			err := CommandCatch(context.Background(), cfg, "pikachu")
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("catch: expected error containing %q, got %v", tt.want, err)
+			}
+			err = CommandExplore(context.Background(), cfg, "canalave-city-area")
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("explore: expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestCommandExploreWithFake(t *testing.T) {
+	fake := &fakeClient{areas: map[string]pokeapi.Area{
+		"test-area": fromJSON[pokeapi.Area](t, `{"location": {"name": "test-town"}, "pokemon_encounters": [{"pokemon": {"name": "pikachu"}}]}`),
+	}}
+	cfg := setupFakeConfig(t, fake)
+
+	output, err := runCaptured(t, func() error { return CommandExplore(context.Background(), cfg, "test-area") })
+	if err != nil {
+		t.Fatalf("CommandExplore failed: %v", err)
+	}
+	// A single encounter is always discovered on the first visit
+	if !cfg.Discoveries.IsDiscovered("test-town", "pikachu") || !strings.Contains(output, "1/1 Pokemon discovered") {
+		t.Errorf("Expected pikachu to be discovered in test-town. Got:\n%s", output)
+	}
+}
+
+func TestCommandLookup(t *testing.T) {
+	fake := &fakeClient{
+		types: map[string]pokeapi.Type{
+			"electric": fromJSON[pokeapi.Type](t, `{"name": "electric", "damage_relations": {
+				"double_damage_to": [{"name": "water"}, {"name": "flying"}], "no_damage_to": [{"name": "ground"}],
+				"double_damage_from": [{"name": "ground"}]}}`),
+		},
+		moves: map[string]pokeapi.Move{
+			"thunderbolt": fromJSON[pokeapi.Move](t, `{"name": "thunderbolt", "power": 90, "pp": 15, "accuracy": 100, "effect_chance": 10,
+				"type": {"name": "electric"}, "damage_class": {"name": "special"},
+				"effect_entries": [{"short_effect": "Has a $effect_chance% chance to paralyze the target.", "language": {"name": "en"}}]}`),
+			"splash": fromJSON[pokeapi.Move](t, `{"name": "splash", "power": null, "pp": 40, "accuracy": null, "type": {"name": "normal"}, "damage_class": {"name": "status"}}`),
+		},
+		abilities: map[string]pokeapi.Ability{
+			"static": fromJSON[pokeapi.Ability](t, `{"name": "static",
+				"effect_entries": [{"short_effect": "May paralyze on contact.", "language": {"name": "en"}}],
+				"pokemon": [{"pokemon": {"name": "pikachu"}}, {"is_hidden": true, "pokemon": {"name": "electabuzz"}}]}`),
+		},
+	}
+	cfg := setupFakeConfig(t, fake)
+
+	tests := []struct {
+		args []string
+		want []string
+	}{
+		{[]string{"type", "electric"}, []string{"super effective against: water, flying", "no effect on: ground", "weak to: ground"}},
+		{[]string{"move", "Thunderbolt"}, []string{"Type: electric (special)", "90", "Effect: Has a 10% chance to paralyze the target."}},
+		{[]string{"move", "splash"}, []string{"Power: \033[32m-\033[0m", "Type: normal (status)"}},
+		{[]string{"ability", "static"}, []string{"Effect: May paralyze on contact.", "- pikachu\n", "- electabuzz (hidden)"}},
+	}
+	for _, tt := range tests {
+		output, err := runCaptured(t, func() error { return CommandLookup(context.Background(), cfg, tt.args...) })
+		if err != nil {
+			t.Errorf("lookup %v failed: %v", tt.args, err)
+			continue
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(output, want) {
+				t.Errorf("lookup %v: expected output to contain %q. Got:\n%s", tt.args, want, output)
+			}
+		}
+	}
+
+	if err := CommandLookup(context.Background(), cfg, "move", "hyper-beam"); err == nil || err.Error() != "no such move: hyper-beam" {
+		t.Errorf("Expected a not found error for an unknown move, got %v", err)
+	}
+	if err := CommandLookup(context.Background(), cfg, "berry", "oran"); err == nil {
+		t.Errorf("Expected an error for an unknown kind")
+	}
+}
+
+func TestCommandEvolution(t *testing.T) {
+	fake := &fakeClient{
+		species: map[string]pokeapi.PokemonSpecies{
+			"pikachu": fromJSON[pokeapi.PokemonSpecies](t, `{"name": "pikachu", "evolution_chain": {"url": "https://pokeapi.co/api/v2/evolution-chain/10/"}}`),
+			"eevee":   fromJSON[pokeapi.PokemonSpecies](t, `{"name": "eevee", "evolution_chain": {"url": "https://pokeapi.co/api/v2/evolution-chain/67/"}}`),
+		},
+		chains: map[int]pokeapi.EvolutionChain{
+			10: fromJSON[pokeapi.EvolutionChain](t, `{"id": 10, "chain": {"species": {"name": "pichu"},
+				"evolves_to": [{"species": {"name": "pikachu"}, "evolution_details": [{"trigger": {"name": "level-up"}, "min_happiness": 220}],
+					"evolves_to": [{"species": {"name": "raichu"}, "evolution_details": [{"trigger": {"name": "use-item"}, "item": {"name": "thunder-stone"}}]}]}]}}`),
+			67: fromJSON[pokeapi.EvolutionChain](t, `{"id": 67, "chain": {"species": {"name": "eevee"}, "evolves_to": [
+				{"species": {"name": "vaporeon"}, "evolution_details": [{"trigger": {"name": "use-item"}, "item": {"name": "water-stone"}}]},
+				{"species": {"name": "espeon"}, "evolution_details": [{"trigger": {"name": "level-up"}, "min_happiness": 160, "time_of_day": "day"}]}]}}`),
+		},
+	}
+	cfg := setupFakeConfig(t, fake)
+
+	output, err := runCaptured(t, func() error { return CommandEvolution(context.Background(), cfg, "pikachu") })
+	if err != nil {
+		t.Fatalf("CommandEvolution failed: %v", err)
+	}
+	want := "Evolution chain for pikachu:\npichu\n└─ " + colorGreen + "pikachu" + colorReset + " (level up with friendship 220)\n   └─ raichu (use thunder-stone)\n"
+	if output != want {
+		t.Errorf("Unexpected pikachu chain. Expected:\n%s\nGot:\n%s", want, output)
+	}
+
+	output, err = runCaptured(t, func() error { return CommandEvolution(context.Background(), cfg, "eevee") })
+	if err != nil {
+		t.Fatalf("CommandEvolution failed: %v", err)
+	}
+	if !strings.Contains(output, "├─ vaporeon (use water-stone)") || !strings.Contains(output, "└─ espeon (level up with friendship 160 during the day)") {
+		t.Errorf("Expected branching evolutions. Got:\n%s", output)
+	}
+
+	if err := CommandEvolution(context.Background(), cfg, "missingno"); err == nil || err.Error() != "no such pokemon: missingno" {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
+
+func TestCommandPartyEvolve(t *testing.T) {
+	chain := fromJSON[pokeapi.EvolutionChain](t, `{"id": 10, "chain": {"species": {"name": "pichu"}, "evolves_to": [
+		{"species": {"name": "pikachu"}, "evolution_details": [{"trigger": {"name": "level-up"}, "min_happiness": 220}], "evolves_to": [
+			{"species": {"name": "raichu"}, "evolution_details": [{"trigger": {"name": "use-item"}, "item": {"name": "thunder-stone"}}]}]}]}}`)
+	pikachuSpecies := fromJSON[pokeapi.PokemonSpecies](t, `{"name": "pikachu", "evolution_chain": {"url": "https://pokeapi.co/api/v2/evolution-chain/10/"}}`)
+	fake := &fakeClient{
+		species: map[string]pokeapi.PokemonSpecies{
+			"pikachu": pikachuSpecies,
+			"raichu":  fromJSON[pokeapi.PokemonSpecies](t, `{"name": "raichu", "varieties": [{"is_default": true, "pokemon": {"name": "raichu"}}]}`),
+		},
+		chains: map[int]pokeapi.EvolutionChain{10: chain},
+		pokemon: map[string]pokeapi.Pokemon{
+			"pikachu": fromJSON[pokeapi.Pokemon](t, `{"name": "pikachu", "species": {"name": "pikachu"}}`),
+			"raichu":  fromJSON[pokeapi.Pokemon](t, `{"name": "raichu", "species": {"name": "raichu"}, "stats": [{"base_stat": 60, "stat": {"name": "hp"}}]}`),
+		},
+	}
+	cfg := setupFakeConfig(t, fake)
+	useScratchPersistence(t, cfg, ".test_evolve_pokedata.json")
+
+	// A raichu already in the party must not be confused with the evolved pikachu
+	existing := party.NewPartyPokemon(fake.pokemon["raichu"])
+	member := party.NewPartyPokemon(fake.pokemon["pikachu"])
+	member.IVs = party.Stats{}
+	for _, m := range []*party.PartyPokemon{existing, member} {
+		if err := cfg.Party.AddMember(m); err != nil {
+			t.Fatalf("AddMember failed: %v", err)
+		}
+	}
+
+	output, err := runCaptured(t, func() error { return CommandParty(context.Background(), cfg, "evolve", "pikachu") })
+	if err != nil || !strings.Contains(output, "pikachu can't evolve yet") {
+		t.Errorf("Expected pikachu not to evolve by level, got %v:\n%s", err, output)
+	}
+
+	output, err = runCaptured(t, func() error { return CommandParty(context.Background(), cfg, "use", "thunder-stone", "pikachu") })
+	if err != nil || !strings.Contains(output, "pikachu evolved from pikachu into raichu!") {
+		t.Fatalf("Expected pikachu to evolve with a thunder-stone, got %v:\n%s", err, output)
+	}
+
+	// The member keeps its nickname, so it's still found by its old name
+	evolved, found := cfg.Party.GetMember("pikachu")
+	if !found || evolved != member || evolved.BasePokemon.Name != "raichu" || evolved.CurrentStats.HP != 21 || len(evolved.History) != 1 {
+		t.Errorf("Unexpected evolved member: %+v", evolved)
+	}
+	if raichu, found := cfg.Party.GetMember("raichu"); !found || raichu != existing {
+		t.Errorf("Expected 'raichu' to still be the original raichu, got %+v", raichu)
+	}
+	if _, ok := cfg.CaughtPokemon["raichu"]; !ok {
+		t.Errorf("Expected raichu to be added to the pokedex")
+	}
+
+	output, err = runCaptured(t, func() error { return CommandParty(context.Background(), cfg, "inspect", "pikachu") })
+	if err != nil || !strings.Contains(output, "evolved from pikachu into raichu at level 5 (use thunder-stone)") {
+		t.Errorf("Expected the evolution in pikachu's history, got %v:\n%s", err, output)
+	}
+}
+
+// mediumGrowth is the "medium" growth rate, where level n needs n^3 experience.
+func mediumGrowth() pokeapi.GrowthRate {
+	rate := pokeapi.GrowthRate{Name: "medium"}
+	for level := 1; level <= pokeapi.MaxLevel; level++ {
+		rate.Levels = append(rate.Levels, pokeapi.GrowthRateExperienceLevel{Level: level, Experience: level * level * level})
+	}
+	rate.Levels[0].Experience = 0
+	return rate
+}
+
+func TestCommandTrain(t *testing.T) {
+	fake := &fakeClient{
+		species: map[string]pokeapi.PokemonSpecies{
+			"bulbasaur": fromJSON[pokeapi.PokemonSpecies](t, `{"name": "bulbasaur", "growth_rate": {"name": "medium"}, "evolution_chain": {"url": "https://pokeapi.co/api/v2/evolution-chain/1/"}}`),
+			"ivysaur":   fromJSON[pokeapi.PokemonSpecies](t, `{"name": "ivysaur", "growth_rate": {"name": "medium"}, "evolution_chain": {"url": "https://pokeapi.co/api/v2/evolution-chain/1/"}}`),
+		},
+		chains: map[int]pokeapi.EvolutionChain{
+			1: fromJSON[pokeapi.EvolutionChain](t, `{"id": 1, "chain": {"species": {"name": "bulbasaur"}, "evolves_to": [
+				{"species": {"name": "ivysaur"}, "evolution_details": [{"trigger": {"name": "level-up"}, "min_level": 16}]}]}}`),
+		},
+		rates: map[string]pokeapi.GrowthRate{"medium": mediumGrowth()},
+		pokemon: map[string]pokeapi.Pokemon{
+			"bulbasaur": fromJSON[pokeapi.Pokemon](t, `{"name": "bulbasaur", "base_experience": 64, "species": {"name": "bulbasaur"}}`),
+			"ivysaur":   fromJSON[pokeapi.Pokemon](t, `{"name": "ivysaur", "base_experience": 142, "species": {"name": "ivysaur"}}`),
+		},
+	}
+	cfg := setupFakeConfig(t, fake)
+	useScratchPersistence(t, cfg, ".test_train_pokedata.json")
+
+	member := party.NewPartyPokemon(fake.pokemon["bulbasaur"])
+	if err := cfg.Party.AddMember(member); err != nil {
+		t.Fatalf("AddMember failed: %v", err)
 	}
 
-	if !exitCalled {
-		t.Errorf("os.Exit(0) was not called by CommandExit")
+	// 64*5/7 = 45 experience on top of the 125 level 5 needs
+	output, err := runCaptured(t, func() error { return CommandTrain(context.Background(), cfg, "bulbasaur") })
+	if err != nil || !strings.Contains(output, "bulbasaur gained 45 experience.") || member.Experience != 170 || member.Level != 5 {
+		t.Fatalf("Expected 45 experience and no level-up, got %v, level %d, %d experience:\n%s", err, member.Level, member.Experience, output)
+	}
+
+	member.Experience = 4000
+	output, err = runCaptured(t, func() error { return CommandTrain(context.Background(), cfg, "bulbasaur", "2") })
+	if err != nil {
+		t.Fatalf("CommandTrain failed: %v", err)
+	}
+	if !strings.Contains(output, "grew to level 16!") || !strings.Contains(output, "evolved from bulbasaur into ivysaur!") {
+		t.Errorf("Expected bulbasaur to reach level 16 and evolve. Got:\n%s", output)
+	}
+	if member.BasePokemon.Name != "ivysaur" || member.Level < 16 {
+		t.Errorf("Unexpected member after training: level %d %s", member.Level, member.BasePokemon.Name)
 	}
 
-	// Optional: Check if save file was created/written
-	if _, err := os.Stat(".test_pokedata.json"); os.IsNotExist(err) {
-		t.Errorf("Expected save file '.test_pokedata.json' to be created, but it wasn't")
+	for _, args := range [][]string{{}, {"bulbasaur", "0"}, {"bulbasaur", "11"}, {"mew"}} {
+		if err := CommandTrain(context.Background(), cfg, args...); err == nil {
+			t.Errorf("Expected an error for train %v", args)
+		}
 	}
 }
 
-// TODO: Add tests for command_map
-// TODO: Add tests for command_explore
-// TODO: Add tests for command_catch
-// TODO: Add tests for command_inspect
-// TODO: Add tests for command_pokedex
-// TODO: Add tests for command_party
+func TestCommandTrainEvolvesIntoDefaultVariety(t *testing.T) {
+	// toxtricity's default Pokemon isn't named after the species
+	fake := &fakeClient{
+		species: map[string]pokeapi.PokemonSpecies{
+			"toxel": fromJSON[pokeapi.PokemonSpecies](t, `{"name": "toxel", "growth_rate": {"name": "medium"}, "evolution_chain": {"url": "https://pokeapi.co/api/v2/evolution-chain/458/"}}`),
+			"toxtricity": fromJSON[pokeapi.PokemonSpecies](t, `{"name": "toxtricity", "varieties": [
+				{"is_default": true, "pokemon": {"name": "toxtricity-amped"}}, {"is_default": false, "pokemon": {"name": "toxtricity-low-key"}}]}`),
+		},
+		chains: map[int]pokeapi.EvolutionChain{
+			458: fromJSON[pokeapi.EvolutionChain](t, `{"id": 458, "chain": {"species": {"name": "toxel"}, "evolves_to": [
+				{"species": {"name": "toxtricity"}, "evolution_details": [{"trigger": {"name": "level-up"}, "min_level": 30}]}]}}`),
+		},
+		rates: map[string]pokeapi.GrowthRate{"medium": mediumGrowth()},
+		pokemon: map[string]pokeapi.Pokemon{
+			"toxel":            fromJSON[pokeapi.Pokemon](t, `{"name": "toxel", "base_experience": 48, "species": {"name": "toxel"}}`),
+			"toxtricity-amped": fromJSON[pokeapi.Pokemon](t, `{"name": "toxtricity-amped", "base_experience": 176, "species": {"name": "toxtricity"}}`),
+		},
+	}
+	cfg := setupFakeConfig(t, fake)
+	useScratchPersistence(t, cfg, ".test_variety_pokedata.json")
+
+	member := party.NewPartyPokemon(fake.pokemon["toxel"])
+	member.Level, member.Experience = 29, 30*30*30-1
+	if err := cfg.Party.AddMember(member); err != nil {
+		t.Fatalf("AddMember failed: %v", err)
+	}
 
-// </rewritten_file>
+	output, err := runCaptured(t, func() error { return CommandTrain(context.Background(), cfg, "toxel") })
+	if err != nil {
+		t.Fatalf("CommandTrain failed: %v\n%s", err, output)
+	}
+	if member.BasePokemon.Name != "toxtricity-amped" || member.Species() != "toxtricity" {
+		t.Errorf("Expected toxel to evolve into toxtricity-amped, got %s:\n%s", member.BasePokemon.Name, output)
+	}
+	if _, ok := cfg.CaughtPokemon["toxtricity-amped"]; !ok {
+		t.Errorf("Expected toxtricity-amped to be added to the pokedex")
+	}
+}
+
+func TestCommandCatchAwardsExperience(t *testing.T) {
+	fake := &fakeClient{
+		species: map[string]pokeapi.PokemonSpecies{
+			"pikachu": fromJSON[pokeapi.PokemonSpecies](t, `{"name": "pikachu", "growth_rate": {"name": "medium"}}`),
+		},
+		rates: map[string]pokeapi.GrowthRate{"medium": mediumGrowth()},
+		pokemon: map[string]pokeapi.Pokemon{
+			"pikachu": fromJSON[pokeapi.Pokemon](t, `{"name": "pikachu", "base_experience": 112, "species": {"name": "pikachu"}}`),
+			// magikarp's base experience is too low for it to ever escape
+			"magikarp": fromJSON[pokeapi.Pokemon](t, `{"name": "magikarp", "base_experience": 40, "species": {"name": "magikarp"},
+				"stats": [{"base_stat": 80, "effort": 1, "stat": {"name": "speed"}}]}`),
+		},
+		lists: map[string][]string{"nature": {"timid"}},
+		natures: map[string]pokeapi.Nature{
+			"timid": fromJSON[pokeapi.Nature](t, `{"name": "timid", "increased_stat": {"name": "speed"}, "decreased_stat": {"name": "attack"}}`),
+		},
+	}
+	cfg := setupFakeConfig(t, fake)
+	useScratchPersistence(t, cfg, ".test_catch_pokedata.json")
+
+	pikachu := party.NewPartyPokemon(fake.pokemon["pikachu"])
+	if err := cfg.Party.AddMember(pikachu); err != nil {
+		t.Fatalf("AddMember failed: %v", err)
+	}
+
+	output, err := runCaptured(t, func() error { return CommandCatch(context.Background(), cfg, "magikarp") })
+	if err != nil {
+		t.Fatalf("CommandCatch failed: %v", err)
+	}
+	// 40*5/7 = 28 experience on top of the 125 level 5 needs
+	if !strings.Contains(output, "pikachu gained 28 experience.") || pikachu.Experience != 153 {
+		t.Errorf("Expected pikachu to gain 28 experience, got %d:\n%s", pikachu.Experience, output)
+	}
+	if magikarp, found := cfg.Party.GetMember("magikarp"); !found || magikarp.Experience != 0 {
+		t.Errorf("Expected magikarp to join the party without experience, got %+v", magikarp)
+	}
+	if pikachu.EVs.Speed != 1 {
+		t.Errorf("Expected pikachu to gain magikarp's speed EV, got %+v", pikachu.EVs)
+	}
+
+	output, err = runCaptured(t, func() error { return CommandParty(context.Background(), cfg, "inspect", "magikarp") })
+	if err != nil || !strings.Contains(output, "Nature: \033[32mtimid (+speed, -attack)\033[0m") || !strings.Contains(output, "(IV ") {
+		t.Errorf("Expected magikarp's nature and IVs in inspect, got %v:\n%s", err, output)
+	}
+}
+
+// runCaptured runs fn with stdout redirected and returns what it printed.
+func runCaptured(t *testing.T, fn func() error) (string, error) {
+	t.Helper()
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := fn()
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	var buf bytes.Buffer
+	io.Copy(&buf, r)
+	return buf.String(), err
+}
+
+func TestCommandMap(t *testing.T) {
+	cfg := setupReplayConfig(t)
+
+	output, err := runCaptured(t, func() error { return CommandMapf(context.Background(), cfg) })
+	if err != nil {
+		t.Fatalf("CommandMapf returned an unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(output, "page 1/55 (locations 1-20 of 1089)\ncanalave-city-area\n") || strings.Count(output, "\n") != 21 {
+		t.Errorf("Expected the first 20 locations under a header, got:\n%s", output)
+	}
+	if err := CommandMapb(context.Background(), cfg); err == nil {
+		t.Errorf("Expected mapb on the first page to fail")
+	}
+}
+
+func TestCommandExplore(t *testing.T) {
+	cfg := setupReplayConfig(t)
+
+	output, err := runCaptured(t, func() error { return CommandExplore(context.Background(), cfg, "canalave-city-area") })
+	if err != nil {
+		t.Fatalf("CommandExplore returned an unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "Exploring canalave-city-area...") {
+		t.Errorf("Explore output missing area header. Got:\n%s", output)
+	}
+	if n := cfg.Discoveries.CountDiscoveredInLocation("canalave-city"); n < 1 || n > 3 {
+		t.Errorf("Expected 1-3 discoveries in canalave-city, got %d", n)
+	}
+
+	_, err = runCaptured(t, func() error { return CommandExplore(context.Background(), cfg, "no-such-area") })
+	if err == nil || !strings.Contains(err.Error(), "no such area") {
+		t.Errorf("Expected a no such area error, got %v", err)
+	}
+}
+
+func TestCommandCatch(t *testing.T) {
+	cfg := setupReplayConfig(t)
+
+	// Magikarp's base experience is 40, so the catch roll can't exceed the threshold
+	output, err := runCaptured(t, func() error { return CommandCatch(context.Background(), cfg, "magikarp") })
+	if err != nil {
+		t.Fatalf("CommandCatch returned an unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "magikarp was caught!") {
+		t.Errorf("Expected magikarp to be caught. Got:\n%s", output)
+	}
+	if _, ok := cfg.CaughtPokemon["magikarp"]; !ok {
+		t.Errorf("Expected magikarp in the pokedex")
+	}
+	if _, ok := cfg.Party.GetMember("magikarp"); !ok {
+		t.Errorf("Expected magikarp in the party")
+	}
+
+	_, err = runCaptured(t, func() error { return CommandCatch(context.Background(), cfg, "missingno") })
+	if err == nil || !strings.Contains(err.Error(), "no such pokemon") {
+		t.Errorf("Expected a no such pokemon error, got %v", err)
+	}
+}
+
+func TestCommandInspectAndPokedex(t *testing.T) {
+	cfg := setupReplayConfig(t)
+
+	if err := CommandInspect(context.Background(), cfg, "magikarp"); err == nil {
+		t.Errorf("Expected inspecting an uncaught pokemon to fail")
+	}
+
+	if _, err := runCaptured(t, func() error { return CommandCatch(context.Background(), cfg, "magikarp") }); err != nil {
+		t.Fatalf("CommandCatch returned an unexpected error: %v", err)
+	}
+
+	output, err := runCaptured(t, func() error { return CommandInspect(context.Background(), cfg, "magikarp") })
+	if err != nil {
+		t.Fatalf("CommandInspect returned an unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "Name: magikarp") || !strings.Contains(output, "  - water") {
+		t.Errorf("Inspect output missing details. Got:\n%s", output)
+	}
+
+	output, err = runCaptured(t, func() error { return CommandPokedex(context.Background(), cfg) })
+	if err != nil {
+		t.Fatalf("CommandPokedex returned an unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "You have caught 1 pokemon") || !strings.Contains(output, "  - magikarp") {
+		t.Errorf("Pokedex output missing magikarp. Got:\n%s", output)
+	}
+}
+
+func TestCommandParty(t *testing.T) {
+	cfg := setupReplayConfig(t)
+
+	output, err := runCaptured(t, func() error { return CommandParty(context.Background(), cfg) })
+	if err != nil || !strings.Contains(output, "No party members found") {
+		t.Errorf("Expected an empty party, got %v:\n%s", err, output)
+	}
+
+	if _, err := runCaptured(t, func() error { return CommandCatch(context.Background(), cfg, "magikarp") }); err != nil {
+		t.Fatalf("CommandCatch returned an unexpected error: %v", err)
+	}
+
+	output, err = runCaptured(t, func() error { return CommandParty(context.Background(), cfg, "inspect", "magikarp") })
+	if err != nil || !strings.Contains(output, "Species: \033[32mmagikarp") {
+		t.Errorf("Expected magikarp's details, got %v:\n%s", err, output)
+	}
+
+	if _, err := runCaptured(t, func() error { return CommandParty(context.Background(), cfg, "remove", "magikarp") }); err != nil {
+		t.Fatalf("party remove returned an unexpected error: %v", err)
+	}
+	if len(cfg.Party.Members) != 0 {
+		t.Errorf("Expected an empty party after remove, got %d members", len(cfg.Party.Members))
+	}
+	for _, args := range [][]string{{"remove"}, {"inspect"}, {"evolve"}, {"use", "thunder-stone"}} {
+		if err := CommandParty(context.Background(), cfg, args...); err == nil {
+			t.Errorf("Expected an error for party %v", args)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package config
 
 import (
+	"io"
+
+	"github.com/sakuffo/pokedexcli/internal/cache"
 	"github.com/sakuffo/pokedexcli/internal/discovery"
 	"github.com/sakuffo/pokedexcli/internal/logger"
 	"github.com/sakuffo/pokedexcli/internal/party"
@@ -10,12 +13,19 @@ import (
 
 // Config holds the runtime state of the application but doesn't initialize it.
 type Config struct {
-	PokeapiClient    pokeapi.Client
-	NextLocationsURL *string
-	PrevLocationsURL *string
-	CaughtPokemon    map[string]pokeapi.Pokemon
-	Discoveries      *discovery.DiscoveryTracker
-	Persistence      *persistence.Persistence
-	Logger           *logger.Logger
-	Party            *party.Party
+	// PokeapiClient is the HTTP client, the imported dataset or a test fake.
+	PokeapiClient pokeapi.PokemonClient
+	// Locations tracks the map command's position in the location-area list.
+	// It is created on first use.
+	Locations *pokeapi.Paginator
+	// SearchIndex caches the names of every resource of a list endpoint, keyed by
+	// endpoint, once search has fetched them.
+	SearchIndex   map[string][]string
+	CaughtPokemon map[string]pokeapi.Pokemon
+	Discoveries   *discovery.DiscoveryTracker
+	Persistence   *persistence.Persistence
+	Logger        *logger.Logger
+	Party         *party.Party
+	Cache         cache.Store
+	LogFile       io.Closer
 }
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// File holds settings read from the optional JSON config file.
+// Fields left out of the file are nil or empty, so callers only override what was set.
+type File struct {
+	LogLevel   string   `json:"log_level"`
+	CacheDir   string   `json:"cache_dir"`
+	APIBaseURL string   `json:"api_base_url"`
+	Timeout    Duration `json:"timeout"`
+	UserAgent  string   `json:"user_agent"`
+	RateLimit  *float64 `json:"rate_limit"`
+	RateBurst  *int     `json:"rate_burst"`
+}
+
+// Duration is a time.Duration written as a string in the config file, e.g. "10s".
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string like \"10s\": %w", err)
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// LoadFile reads the config file at path. Unknown keys are rejected so typos
+// don't silently fall back to defaults. A missing file returns an error wrapping
+// fs.ErrNotExist, which callers may treat as an empty config.
+func LoadFile(path string) (File, error) {
+	var file File
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return file, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&file); err != nil {
+		return file, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+
+	return file, nil
+}
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeConfigFile(t *testing.T, contents string) string {
+	path := filepath.Join(t.TempDir(), "pokedexcli.json")
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	return path
+}
+
+func TestLoadFile(t *testing.T) {
+	path := writeConfigFile(t, `{
+		"api_base_url": "http://localhost:8000/api/v2",
+		"timeout": "10s",
+		"rate_limit": 0
+	}`)
+
+	file, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile failed: %v", err)
+	}
+	if file.APIBaseURL != "http://localhost:8000/api/v2" || time.Duration(file.Timeout) != 10*time.Second {
+		t.Errorf("Unexpected settings: %+v", file)
+	}
+	// An explicit zero must be distinguishable from an unset field
+	if file.RateLimit == nil || *file.RateLimit != 0 || file.RateBurst != nil {
+		t.Errorf("Unexpected rate limit settings: %v, %v", file.RateLimit, file.RateBurst)
+	}
+}
+
+func TestLoadFileErrors(t *testing.T) {
+	if _, err := LoadFile(filepath.Join(t.TempDir(), "missing.json")); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Expected fs.ErrNotExist for a missing file, got %v", err)
+	}
+	if _, err := LoadFile(writeConfigFile(t, `{"api_base_uri": "http://localhost"}`)); err == nil {
+		t.Errorf("Expected an error for an unknown key")
+	}
+	if _, err := LoadFile(writeConfigFile(t, `{"timeout": "soon"}`)); err == nil {
+		t.Errorf("Expected an error for an invalid duration")
+	}
+}
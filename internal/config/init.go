@@ -39,7 +39,7 @@ func New(logLevel logger.LogLevel) *Config {
 	}
 
 	appCache := cache.NewCache(5*time.Minute, appLogger)
-	pokeClient := pokeapi.NewClient(5*time.Second, appCache, appLogger)
+	pokeClient := pokeapi.NewClient(pokeapi.WithCache(appCache), pokeapi.WithLogger(appLogger))
 
 	// Use the new persistence package
 	persister, err := persistence.NewPersistence("pokedata.json")
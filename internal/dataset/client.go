@@ -0,0 +1,597 @@
+package dataset
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/sakuffo/pokedexcli/internal/logger"
+	"github.com/sakuffo/pokedexcli/internal/pokeapi"
+)
+
+// apiURL is used to build resource links so responses look exactly like PokeAPI's.
+const apiURL = "https://pokeapi.co/api/v2"
+
+// Client answers PokeAPI requests from an imported Dataset without touching the network.
+// Responses are rendered in PokeAPI's JSON shape and decoded into the pokeapi types,
+// so callers can't tell it apart from the HTTP client.
+type Client struct {
+	ds     *Dataset
+	logger *logger.Logger
+}
+
+var _ pokeapi.PokemonClient = (*Client)(nil)
+
+func NewClient(ds *Dataset, logger *logger.Logger) *Client {
+	logger.Debug("Creating new dataset client")
+	return &Client{ds: ds, logger: logger}
+}
+
+type namedResource struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+func ref(kind, name string, id int) namedResource {
+	return namedResource{Name: name, URL: fmt.Sprintf("%s/%s/%d/", apiURL, kind, id)}
+}
+
+func (c *Client) ListLocations(ctx context.Context, pageURL *string) (pokeapi.Locations, error) {
+	offset, limit := 0, pokeapi.DefaultPageSize
+	if pageURL != nil {
+		parsed, err := url.Parse(*pageURL)
+		if err != nil {
+			return pokeapi.Locations{}, err
+		}
+		offset, limit = pageParams(parsed.Query())
+	}
+	return c.ListResources(ctx, "location-area", offset, limit)
+}
+
+func (c *Client) ListResources(ctx context.Context, endpoint string, offset, limit int) (pokeapi.NamedAPIResourceList, error) {
+	all, ok := c.listing(endpoint)
+	if !ok {
+		return pokeapi.NamedAPIResourceList{}, fmt.Errorf("list %q: %w", endpoint, pokeapi.ErrNotFound)
+	}
+	if limit <= 0 {
+		limit = pokeapi.DefaultPageSize
+	}
+	c.logger.Debug("Listing dataset %s at offset %d, limit %d", endpoint, offset, limit)
+
+	total := len(all)
+	end := min(max(offset, 0)+limit, total)
+	start := min(max(offset, 0), end)
+
+	body := map[string]any{
+		"count":    total,
+		"next":     nil,
+		"previous": nil,
+		"results":  all[start:end],
+	}
+	if end < total {
+		body["next"] = pageURLFor(endpoint, end, limit)
+	}
+	if start > 0 {
+		body["previous"] = pageURLFor(endpoint, max(start-limit, 0), limit)
+	}
+
+	return decode[pokeapi.NamedAPIResourceList](body)
+}
+
+// listing returns every resource of a list endpoint in id order, as PokeAPI lists them.
+func (c *Client) listing(endpoint string) ([]namedResource, bool) {
+	ids := make(map[string]int)
+	switch endpoint {
+	case "location-area":
+		for name, a := range c.ds.Areas {
+			ids[name] = a.ID
+		}
+	case "pokemon":
+		for name, p := range c.ds.Pokemon {
+			ids[name] = p.ID
+		}
+	case "pokemon-species":
+		for name, s := range c.ds.Species {
+			ids[name] = s.ID
+		}
+	case "type":
+		for name, t := range c.ds.Types {
+			ids[name] = t.ID
+		}
+	case "move":
+		for name, m := range c.ds.Moves {
+			ids[name] = m.ID
+		}
+	case "ability":
+		for name, a := range c.ds.Abilities {
+			ids[name] = a.ID
+		}
+	case "nature":
+		for name, n := range c.ds.Natures {
+			ids[name] = n.ID
+		}
+	case "growth-rate":
+		for name, g := range c.ds.GrowthRates {
+			ids[name] = g.ID
+		}
+	default:
+		return nil, false
+	}
+
+	names := slices.SortedFunc(maps.Keys(ids), func(a, b string) int {
+		return cmp.Compare(ids[a], ids[b])
+	})
+
+	all := make([]namedResource, 0, len(names))
+	for _, name := range names {
+		all = append(all, ref(endpoint, name, ids[name]))
+	}
+	return all, true
+}
+
+func (c *Client) FetchAreaPokemon(ctx context.Context, area string) (pokeapi.Area, error) {
+	if area == "" {
+		return pokeapi.Area{}, errors.New("area is required")
+	}
+
+	a, ok := c.ds.Areas[area]
+	if !ok {
+		return pokeapi.Area{}, fmt.Errorf("location area %q: %w", area, pokeapi.ErrNotFound)
+	}
+
+	encounters := make([]map[string]namedResource, 0, len(a.Pokemon))
+	for _, name := range a.Pokemon {
+		encounters = append(encounters, map[string]namedResource{
+			"pokemon": ref("pokemon", name, c.ds.Pokemon[name].ID),
+		})
+	}
+
+	return decode[pokeapi.Area](map[string]any{
+		"id":                 a.ID,
+		"name":               a.Name,
+		"location":           namedResource{Name: a.Location},
+		"pokemon_encounters": encounters,
+	})
+}
+
+func (c *Client) FetchPokemon(ctx context.Context, pokemonName string) (pokeapi.Pokemon, error) {
+	if pokemonName == "" {
+		return pokeapi.Pokemon{}, errors.New("name is required")
+	}
+
+	p, ok := c.ds.Pokemon[pokemonName]
+	if !ok {
+		return pokeapi.Pokemon{}, fmt.Errorf("pokemon %q: %w", pokemonName, pokeapi.ErrNotFound)
+	}
+
+	abilities := make([]map[string]any, 0, len(p.Abilities))
+	for _, a := range p.Abilities {
+		abilities = append(abilities, map[string]any{
+			"is_hidden": a.IsHidden,
+			"slot":      a.Slot,
+			"ability":   namedResource{Name: a.Name},
+		})
+	}
+
+	moves := make([]map[string]namedResource, 0, len(p.Moves))
+	for _, m := range p.Moves {
+		moves = append(moves, map[string]namedResource{"move": {Name: m}})
+	}
+
+	stats := make([]map[string]any, 0, len(p.Stats))
+	for _, s := range p.Stats {
+		stats = append(stats, map[string]any{
+			"base_stat": s.Base,
+			"effort":    s.Effort,
+			"stat":      namedResource{Name: s.Name},
+		})
+	}
+
+	types := make([]map[string]any, 0, len(p.Types))
+	for i, t := range p.Types {
+		types = append(types, map[string]any{
+			"slot": i + 1,
+			"type": namedResource{Name: t},
+		})
+	}
+
+	species := namedResource{Name: p.Species}
+	if s, ok := c.ds.Species[p.Species]; ok {
+		species = ref("pokemon-species", s.Name, s.ID)
+	}
+
+	return decode[pokeapi.Pokemon](map[string]any{
+		"id":                       p.ID,
+		"name":                     p.Name,
+		"base_experience":          p.BaseExperience,
+		"height":                   p.Height,
+		"weight":                   p.Weight,
+		"is_default":               p.IsDefault,
+		"order":                    p.Order,
+		"abilities":                abilities,
+		"location_area_encounters": fmt.Sprintf("%s/pokemon/%d/encounters", apiURL, p.ID),
+		"moves":                    moves,
+		"species":                  species,
+		"stats":                    stats,
+		"types":                    types,
+	})
+}
+
+func (c *Client) FetchPokemonSpecies(ctx context.Context, name string) (pokeapi.PokemonSpecies, error) {
+	if name == "" {
+		return pokeapi.PokemonSpecies{}, errors.New("name is required")
+	}
+
+	s, ok := c.ds.Species[name]
+	if !ok {
+		return pokeapi.PokemonSpecies{}, fmt.Errorf("pokemon species %q: %w", name, pokeapi.ErrNotFound)
+	}
+
+	body := map[string]any{
+		"id":                   s.ID,
+		"name":                 s.Name,
+		"capture_rate":         s.CaptureRate,
+		"base_happiness":       s.BaseHappiness,
+		"is_baby":              s.IsBaby,
+		"is_legendary":         s.IsLegendary,
+		"is_mythical":          s.IsMythical,
+		"growth_rate":          namedResource{Name: s.GrowthRate},
+		"evolution_chain":      map[string]string{"url": fmt.Sprintf("%s/evolution-chain/%d/", apiURL, s.EvolutionChainID)},
+		"evolves_from_species": nil,
+		"generation":           ref("generation", s.GenerationName, s.Generation),
+	}
+	if from, ok := c.ds.Species[s.EvolvesFrom]; ok {
+		body["evolves_from_species"] = ref("pokemon-species", from.Name, from.ID)
+	}
+
+	varieties := make([]map[string]any, 0)
+	for _, p := range c.sortedPokemon() {
+		if p.Species == s.Name {
+			varieties = append(varieties, map[string]any{
+				"is_default": p.IsDefault,
+				"pokemon":    ref("pokemon", p.Name, p.ID),
+			})
+		}
+	}
+	body["varieties"] = varieties
+
+	return decode[pokeapi.PokemonSpecies](body)
+}
+
+func (c *Client) FetchGrowthRate(ctx context.Context, name string) (pokeapi.GrowthRate, error) {
+	if name == "" {
+		return pokeapi.GrowthRate{}, errors.New("growth rate name is required")
+	}
+
+	g, ok := c.ds.GrowthRates[name]
+	if !ok {
+		return pokeapi.GrowthRate{}, fmt.Errorf("growth rate %q: %w", name, pokeapi.ErrNotFound)
+	}
+
+	levels := make([]map[string]int, 0, len(g.Experience))
+	for i, experience := range g.Experience {
+		levels = append(levels, map[string]int{"level": i + 1, "experience": experience})
+	}
+
+	species := make([]namedResource, 0)
+	for _, s := range c.sortedSpecies() {
+		if s.GrowthRate == g.Name {
+			species = append(species, ref("pokemon-species", s.Name, s.ID))
+		}
+	}
+
+	return decode[pokeapi.GrowthRate](map[string]any{
+		"id":              g.ID,
+		"name":            g.Name,
+		"formula":         g.Formula,
+		"levels":          levels,
+		"pokemon_species": species,
+	})
+}
+
+func (c *Client) FetchNature(ctx context.Context, name string) (pokeapi.Nature, error) {
+	if name == "" {
+		return pokeapi.Nature{}, errors.New("nature name is required")
+	}
+
+	n, ok := c.ds.Natures[name]
+	if !ok {
+		return pokeapi.Nature{}, fmt.Errorf("nature %q: %w", name, pokeapi.ErrNotFound)
+	}
+
+	return decode[pokeapi.Nature](map[string]any{
+		"id":             n.ID,
+		"name":           n.Name,
+		"increased_stat": optionalRef(n.IncreasedStat),
+		"decreased_stat": optionalRef(n.DecreasedStat),
+	})
+}
+
+func (c *Client) FetchType(ctx context.Context, name string) (pokeapi.Type, error) {
+	if name == "" {
+		return pokeapi.Type{}, errors.New("type name is required")
+	}
+
+	t, ok := c.ds.Types[name]
+	if !ok {
+		return pokeapi.Type{}, fmt.Errorf("type %q: %w", name, pokeapi.ErrNotFound)
+	}
+
+	relations := map[string][]namedResource{
+		"no_damage_to":       {},
+		"half_damage_to":     {},
+		"double_damage_to":   {},
+		"no_damage_from":     {},
+		"half_damage_from":   {},
+		"double_damage_from": {},
+	}
+	relation := map[int]string{0: "no_damage", 50: "half_damage", 200: "double_damage"}
+	for _, other := range c.sortedTypes() {
+		// Efficacy omits normal damage, so only present factors are relations
+		if factor, ok := t.Efficacy[other.Name]; ok && relation[factor] != "" {
+			r := relation[factor]
+			relations[r+"_to"] = append(relations[r+"_to"], ref("type", other.Name, other.ID))
+		}
+		if factor, ok := other.Efficacy[t.Name]; ok && relation[factor] != "" {
+			r := relation[factor]
+			relations[r+"_from"] = append(relations[r+"_from"], ref("type", other.Name, other.ID))
+		}
+	}
+
+	pokemon := make([]map[string]any, 0)
+	for _, name := range c.ds.PokemonByType(t.Name) {
+		p := c.ds.Pokemon[name]
+		pokemon = append(pokemon, map[string]any{
+			"slot":    slices.Index(p.Types, t.Name) + 1,
+			"pokemon": ref("pokemon", p.Name, p.ID),
+		})
+	}
+
+	moves := make([]namedResource, 0)
+	for _, m := range c.sortedMoves() {
+		if m.Type == t.Name {
+			moves = append(moves, ref("move", m.Name, m.ID))
+		}
+	}
+
+	body := map[string]any{
+		"id":                t.ID,
+		"name":              t.Name,
+		"damage_relations":  relations,
+		"generation":        ref("generation", t.GenerationName, t.Generation),
+		"move_damage_class": nil,
+		"pokemon":           pokemon,
+		"moves":             moves,
+	}
+	if t.DamageClass != "" {
+		body["move_damage_class"] = namedResource{Name: t.DamageClass}
+	}
+
+	return decode[pokeapi.Type](body)
+}
+
+func (c *Client) FetchMove(ctx context.Context, name string) (pokeapi.Move, error) {
+	if name == "" {
+		return pokeapi.Move{}, errors.New("move name is required")
+	}
+
+	m, ok := c.ds.Moves[name]
+	if !ok {
+		return pokeapi.Move{}, fmt.Errorf("move %q: %w", name, pokeapi.ErrNotFound)
+	}
+
+	moveType := namedResource{Name: m.Type}
+	if t, ok := c.ds.Types[m.Type]; ok {
+		moveType = ref("type", t.Name, t.ID)
+	}
+
+	return decode[pokeapi.Move](map[string]any{
+		"id":             m.ID,
+		"name":           m.Name,
+		"accuracy":       m.Accuracy,
+		"effect_chance":  m.EffectChance,
+		"pp":             m.PP,
+		"priority":       m.Priority,
+		"power":          m.Power,
+		"damage_class":   namedResource{Name: m.DamageClass},
+		"type":           moveType,
+		"generation":     ref("generation", m.GenerationName, m.Generation),
+		"effect_entries": effectEntries(m.Effect, m.ShortEffect),
+	})
+}
+
+func (c *Client) FetchAbility(ctx context.Context, name string) (pokeapi.Ability, error) {
+	if name == "" {
+		return pokeapi.Ability{}, errors.New("ability name is required")
+	}
+
+	a, ok := c.ds.Abilities[name]
+	if !ok {
+		return pokeapi.Ability{}, fmt.Errorf("ability %q: %w", name, pokeapi.ErrNotFound)
+	}
+
+	pokemon := make([]map[string]any, 0)
+	for _, name := range c.ds.PokemonByAbility(a.Name) {
+		p := c.ds.Pokemon[name]
+		for _, slot := range p.Abilities {
+			if slot.Name == a.Name {
+				pokemon = append(pokemon, map[string]any{
+					"is_hidden": slot.IsHidden,
+					"slot":      slot.Slot,
+					"pokemon":   ref("pokemon", p.Name, p.ID),
+				})
+			}
+		}
+	}
+
+	return decode[pokeapi.Ability](map[string]any{
+		"id":             a.ID,
+		"name":           a.Name,
+		"is_main_series": a.IsMainSeries,
+		"generation":     ref("generation", a.GenerationName, a.Generation),
+		"effect_entries": effectEntries(a.Effect, a.ShortEffect),
+		"pokemon":        pokemon,
+	})
+}
+
+func (c *Client) FetchEvolutionChain(ctx context.Context, id int) (pokeapi.EvolutionChain, error) {
+	var members []*SpeciesRecord
+	for _, s := range c.ds.Species {
+		if s.EvolutionChainID == id {
+			members = append(members, s)
+		}
+	}
+	if len(members) == 0 {
+		return pokeapi.EvolutionChain{}, fmt.Errorf("evolution chain %d: %w", id, pokeapi.ErrNotFound)
+	}
+	slices.SortFunc(members, func(a, b *SpeciesRecord) int { return cmp.Compare(a.ID, b.ID) })
+
+	// The root is the first species whose previous stage isn't in the dataset
+	root := members[0]
+	for _, s := range members {
+		if _, ok := c.ds.Species[s.EvolvesFrom]; !ok {
+			root = s
+			break
+		}
+	}
+
+	chain := c.chainLink(root, members)
+	chain["evolution_details"] = []map[string]any{}
+	return decode[pokeapi.EvolutionChain](map[string]any{
+		"id":                id,
+		"baby_trigger_item": nil,
+		"chain":             chain,
+	})
+}
+
+// chainLink renders s and the members of its chain that evolve from it.
+func (c *Client) chainLink(s *SpeciesRecord, members []*SpeciesRecord) map[string]any {
+	evolvesTo := make([]map[string]any, 0)
+	for _, next := range members {
+		if next.EvolvesFrom == s.Name {
+			evolvesTo = append(evolvesTo, c.chainLink(next, members))
+		}
+	}
+
+	details := make([]map[string]any, 0, len(s.Evolution))
+	for _, e := range s.Evolution {
+		details = append(details, map[string]any{
+			"trigger":                 namedResource{Name: e.Trigger},
+			"min_level":               nonZero(e.MinLevel),
+			"item":                    optionalRef(e.Item),
+			"held_item":               optionalRef(e.HeldItem),
+			"known_move":              optionalRef(e.KnownMove),
+			"known_move_type":         optionalRef(e.KnownMoveType),
+			"location":                optionalRef(e.Location),
+			"min_happiness":           nonZero(e.MinHappiness),
+			"min_beauty":              nonZero(e.MinBeauty),
+			"min_affection":           nonZero(e.MinAffection),
+			"gender":                  nonZero(e.Gender),
+			"relative_physical_stats": e.RelativePhysicalStats,
+			"party_species":           optionalRef(e.PartySpecies),
+			"party_type":              optionalRef(e.PartyType),
+			"trade_species":           optionalRef(e.TradeSpecies),
+			"time_of_day":             e.TimeOfDay,
+			"needs_overworld_rain":    e.NeedsOverworldRain,
+			"turn_upside_down":        e.TurnUpsideDown,
+		})
+	}
+
+	return map[string]any{
+		"is_baby":           s.IsBaby,
+		"species":           ref("pokemon-species", s.Name, s.ID),
+		"evolution_details": details,
+		"evolves_to":        evolvesTo,
+	}
+}
+
+// optionalRef renders a link that PokeAPI leaves null when unset.
+func optionalRef(name string) *namedResource {
+	if name == "" {
+		return nil
+	}
+	return &namedResource{Name: name}
+}
+
+// nonZero renders a condition that PokeAPI leaves null when unset.
+func nonZero(n int) *int {
+	if n == 0 {
+		return nil
+	}
+	return &n
+}
+
+// sortedSpecies returns every species in id order.
+func (c *Client) sortedSpecies() []*SpeciesRecord {
+	return slices.SortedFunc(maps.Values(c.ds.Species), func(a, b *SpeciesRecord) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+}
+
+// sortedPokemon returns every pokemon in id order.
+func (c *Client) sortedPokemon() []*PokemonRecord {
+	return slices.SortedFunc(maps.Values(c.ds.Pokemon), func(a, b *PokemonRecord) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+}
+
+// sortedTypes returns every type in id order.
+func (c *Client) sortedTypes() []*TypeRecord {
+	return slices.SortedFunc(maps.Values(c.ds.Types), func(a, b *TypeRecord) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+}
+
+// sortedMoves returns every move in id order.
+func (c *Client) sortedMoves() []*MoveRecord {
+	return slices.SortedFunc(maps.Values(c.ds.Moves), func(a, b *MoveRecord) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+}
+
+// effectEntries renders English effect text the way PokeAPI does, with no
+// entries at all when the dump had none.
+func effectEntries(effect, shortEffect string) []map[string]any {
+	if effect == "" && shortEffect == "" {
+		return []map[string]any{}
+	}
+	return []map[string]any{{
+		"effect":       effect,
+		"short_effect": shortEffect,
+		"language":     namedResource{Name: "en"},
+	}}
+}
+
+// decode converts a PokeAPI-shaped body into T by round-tripping it through JSON,
+// the same path responses from the HTTP client take.
+func decode[T any](body any) (T, error) {
+	var resource T
+
+	dat, err := json.Marshal(body)
+	if err != nil {
+		return resource, err
+	}
+
+	err = json.Unmarshal(dat, &resource)
+	return resource, err
+}
+
+func pageURLFor(resource string, offset, limit int) string {
+	return fmt.Sprintf("%s/%s?offset=%d&limit=%d", apiURL, resource, offset, limit)
+}
+
+// pageParams reads offset and limit from a list URL's query, defaulting like PokeAPI does.
+func pageParams(query url.Values) (offset, limit int) {
+	offset, _ = strconv.Atoi(query.Get("offset"))
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = pokeapi.DefaultPageSize
+	}
+	return max(offset, 0), limit
+}
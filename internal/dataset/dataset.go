@@ -0,0 +1,281 @@
+package dataset
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// FileName is the name of the imported dataset inside the data directory.
+const FileName = "dataset.json.gz"
+
+// Dataset is a local, indexed copy of the PokeAPI data dump.
+// Records are keyed by their PokeAPI name.
+type Dataset struct {
+	Pokemon map[string]*PokemonRecord `json:"pokemon"`
+	Species map[string]*SpeciesRecord `json:"species"`
+	Areas   map[string]*AreaRecord    `json:"areas"`
+	// Types, Moves and Abilities are empty in datasets imported before they were added.
+	Types     map[string]*TypeRecord          `json:"types"`
+	Moves     map[string]*MoveRecord          `json:"moves"`
+	Abilities map[string]*AbilityDetailRecord `json:"abilities"`
+	// GrowthRates and Natures are empty in datasets imported before they were added.
+	GrowthRates map[string]*GrowthRateRecord `json:"growth_rates"`
+	Natures     map[string]*NatureRecord     `json:"natures"`
+	// AreaOrder lists location-area names by id, the order PokeAPI pages them in.
+	AreaOrder []string `json:"area_order"`
+
+	byType       map[string][]string
+	byGeneration map[int][]string
+	byAbility    map[string][]string
+}
+
+type PokemonRecord struct {
+	ID             int             `json:"id"`
+	Name           string          `json:"name"`
+	Species        string          `json:"species"`
+	Height         int             `json:"height"`
+	Weight         int             `json:"weight"`
+	BaseExperience int             `json:"base_experience"`
+	Order          int             `json:"order"`
+	IsDefault      bool            `json:"is_default"`
+	Stats          []StatRecord    `json:"stats"`
+	Types          []string        `json:"types"` // in slot order
+	Abilities      []AbilityRecord `json:"abilities"`
+	Moves          []string        `json:"moves"`
+}
+
+type StatRecord struct {
+	Name   string `json:"name"`
+	Base   int    `json:"base"`
+	Effort int    `json:"effort"`
+}
+
+type AbilityRecord struct {
+	Name     string `json:"name"`
+	IsHidden bool   `json:"is_hidden"`
+	Slot     int    `json:"slot"`
+}
+
+type SpeciesRecord struct {
+	ID               int    `json:"id"`
+	Name             string `json:"name"`
+	Generation       int    `json:"generation"`
+	GenerationName   string `json:"generation_name"`
+	CaptureRate      int    `json:"capture_rate"`
+	BaseHappiness    int    `json:"base_happiness"`
+	GrowthRate       string `json:"growth_rate"`
+	EvolvesFrom      string `json:"evolves_from,omitempty"`
+	EvolutionChainID int    `json:"evolution_chain_id"`
+	IsBaby           bool   `json:"is_baby"`
+	IsLegendary      bool   `json:"is_legendary"`
+	IsMythical       bool   `json:"is_mythical"`
+	// Evolution lists the ways the previous stage evolves into this species.
+	Evolution []EvolutionRecord `json:"evolution,omitempty"`
+}
+
+// EvolutionRecord is one way of evolving into a species. Unset conditions are
+// zero; Gender is 1 for female and 2 for male, as in PokeAPI.
+type EvolutionRecord struct {
+	Trigger               string `json:"trigger"`
+	MinLevel              int    `json:"min_level,omitempty"`
+	Item                  string `json:"item,omitempty"`
+	HeldItem              string `json:"held_item,omitempty"`
+	KnownMove             string `json:"known_move,omitempty"`
+	KnownMoveType         string `json:"known_move_type,omitempty"`
+	Location              string `json:"location,omitempty"`
+	MinHappiness          int    `json:"min_happiness,omitempty"`
+	MinBeauty             int    `json:"min_beauty,omitempty"`
+	MinAffection          int    `json:"min_affection,omitempty"`
+	Gender                int    `json:"gender,omitempty"`
+	RelativePhysicalStats *int   `json:"relative_physical_stats,omitempty"`
+	PartySpecies          string `json:"party_species,omitempty"`
+	PartyType             string `json:"party_type,omitempty"`
+	TradeSpecies          string `json:"trade_species,omitempty"`
+	TimeOfDay             string `json:"time_of_day,omitempty"`
+	NeedsOverworldRain    bool   `json:"needs_overworld_rain,omitempty"`
+	TurnUpsideDown        bool   `json:"turn_upside_down,omitempty"`
+}
+
+type AreaRecord struct {
+	ID       int      `json:"id"`
+	Name     string   `json:"name"`
+	Location string   `json:"location"`
+	Pokemon  []string `json:"pokemon"`
+}
+
+type TypeRecord struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	Generation     int    `json:"generation"`
+	GenerationName string `json:"generation_name"`
+	DamageClass    string `json:"damage_class,omitempty"`
+	// Efficacy maps defending types to the damage factor in percent, omitting the normal 100.
+	Efficacy map[string]int `json:"efficacy"`
+}
+
+// MoveRecord describes a move. Power, PP, accuracy and effect chance are nil when
+// the move doesn't have them, as in the CSV dump.
+type MoveRecord struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	Type           string `json:"type"`
+	Generation     int    `json:"generation"`
+	GenerationName string `json:"generation_name"`
+	DamageClass    string `json:"damage_class"`
+	Power          *int   `json:"power"`
+	PP             *int   `json:"pp"`
+	Accuracy       *int   `json:"accuracy"`
+	Priority       int    `json:"priority"`
+	EffectChance   *int   `json:"effect_chance"`
+	Effect         string `json:"effect,omitempty"`
+	ShortEffect    string `json:"short_effect,omitempty"`
+}
+
+// AbilityDetailRecord describes an ability itself, as opposed to AbilityRecord
+// which is a Pokemon's slot for one.
+type AbilityDetailRecord struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	Generation     int    `json:"generation"`
+	GenerationName string `json:"generation_name"`
+	IsMainSeries   bool   `json:"is_main_series"`
+	Effect         string `json:"effect,omitempty"`
+	ShortEffect    string `json:"short_effect,omitempty"`
+}
+
+type GrowthRateRecord struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Formula string `json:"formula"`
+	// Experience holds the total experience needed for each level, from level 1.
+	Experience []int `json:"experience"`
+}
+
+// NatureRecord describes a nature. The stats are empty for neutral natures.
+type NatureRecord struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	IncreasedStat string `json:"increased_stat,omitempty"`
+	DecreasedStat string `json:"decreased_stat,omitempty"`
+}
+
+// Load reads a dataset previously written by Save and rebuilds its indexes.
+func Load(path string) (*Dataset, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open dataset: %w", err)
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dataset: %w", err)
+	}
+	defer gz.Close()
+
+	var ds Dataset
+	if err := json.NewDecoder(gz).Decode(&ds); err != nil {
+		return nil, fmt.Errorf("failed to decode dataset: %w", err)
+	}
+
+	ds.buildIndexes()
+	return &ds, nil
+}
+
+// Save writes the dataset to path as gzipped JSON, replacing any previous import.
+func (ds *Dataset) Save(path string) error {
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("failed to create dataset file: %w", err)
+	}
+
+	gz := gzip.NewWriter(f)
+	err = json.NewEncoder(gz).Encode(ds)
+	if closeErr := gz.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to write dataset: %w", err)
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// PokemonByType returns the names of every Pokemon with the given type, ordered by id.
+func (ds *Dataset) PokemonByType(typeName string) []string {
+	return ds.byType[typeName]
+}
+
+// PokemonByAbility returns the names of every Pokemon that can have the ability, ordered by id.
+func (ds *Dataset) PokemonByAbility(ability string) []string {
+	return ds.byAbility[ability]
+}
+
+// PokemonByGeneration returns the names of every Pokemon introduced in generation, ordered by id.
+func (ds *Dataset) PokemonByGeneration(generation int) []string {
+	return ds.byGeneration[generation]
+}
+
+// PokemonByStat returns the names of every Pokemon whose base stat is at least min,
+// highest first.
+func (ds *Dataset) PokemonByStat(stat string, min int) []string {
+	type match struct {
+		name  string
+		value int
+	}
+
+	var matches []match
+	for _, p := range ds.Pokemon {
+		for _, s := range p.Stats {
+			if s.Name == stat && s.Base >= min {
+				matches = append(matches, match{name: p.Name, value: s.Base})
+			}
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].value != matches[j].value {
+			return matches[i].value > matches[j].value
+		}
+		return matches[i].name < matches[j].name
+	})
+
+	names := make([]string, len(matches))
+	for i, m := range matches {
+		names[i] = m.name
+	}
+	return names
+}
+
+// buildIndexes derives the lookup indexes from the records.
+func (ds *Dataset) buildIndexes() {
+	ds.byType = make(map[string][]string)
+	ds.byGeneration = make(map[int][]string)
+	ds.byAbility = make(map[string][]string)
+
+	pokemon := make([]*PokemonRecord, 0, len(ds.Pokemon))
+	for _, p := range ds.Pokemon {
+		pokemon = append(pokemon, p)
+	}
+	sort.Slice(pokemon, func(i, j int) bool { return pokemon[i].ID < pokemon[j].ID })
+
+	for _, p := range pokemon {
+		for _, t := range p.Types {
+			ds.byType[t] = append(ds.byType[t], p.Name)
+		}
+		for _, a := range p.Abilities {
+			ds.byAbility[a.Name] = append(ds.byAbility[a.Name], p.Name)
+		}
+		if species, ok := ds.Species[p.Species]; ok {
+			ds.byGeneration[species.Generation] = append(ds.byGeneration[species.Generation], p.Name)
+		}
+	}
+}
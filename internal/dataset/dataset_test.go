@@ -0,0 +1,319 @@
+package dataset
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sakuffo/pokedexcli/internal/logger"
+	"github.com/sakuffo/pokedexcli/internal/pokeapi"
+)
+
+// setupTestDataset imports the trimmed CSV dump in testdata.
+func setupTestDataset(t *testing.T) *Dataset {
+	ds, err := Import(filepath.Join("testdata", "csv"), logger.New(logger.NONE))
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	return ds
+}
+
+func TestImport(t *testing.T) {
+	ds := setupTestDataset(t)
+
+	pikachu, ok := ds.Pokemon["pikachu"]
+	if !ok {
+		t.Fatalf("Expected pikachu to be imported")
+	}
+	if pikachu.ID != 25 || pikachu.Species != "pikachu" || pikachu.BaseExperience != 112 {
+		t.Errorf("Unexpected pikachu record: %+v", pikachu)
+	}
+	if !reflect.DeepEqual(pikachu.Types, []string{"electric"}) {
+		t.Errorf("Unexpected pikachu types: %v", pikachu.Types)
+	}
+	// pokemon_moves repeats moves per version group; each should appear once
+	if len(pikachu.Moves) != 4 {
+		t.Errorf("Expected 4 unique moves, got %v", pikachu.Moves)
+	}
+
+	if ivysaur := ds.Species["ivysaur"]; ivysaur.EvolvesFrom != "bulbasaur" || ivysaur.GrowthRate != "medium-slow" {
+		t.Errorf("Unexpected ivysaur species: %+v", ivysaur)
+	}
+
+	area, ok := ds.Areas["canalave-city-area"]
+	if !ok {
+		t.Fatalf("Expected canalave-city-area to be imported, got areas %v", ds.AreaOrder)
+	}
+	if len(area.Pokemon) != 5 {
+		t.Errorf("Expected 5 unique encounters, got %v", area.Pokemon)
+	}
+}
+
+func TestImportMissingDump(t *testing.T) {
+	if _, err := Import(t.TempDir(), logger.New(logger.NONE)); err == nil {
+		t.Errorf("Expected error importing an empty directory")
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	ds := setupTestDataset(t)
+	path := filepath.Join(t.TempDir(), FileName)
+
+	if err := ds.Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(loaded.Pokemon, ds.Pokemon) || !reflect.DeepEqual(loaded.AreaOrder, ds.AreaOrder) {
+		t.Errorf("Loaded dataset does not match the saved one")
+	}
+	if len(loaded.PokemonByType("water")) == 0 {
+		t.Errorf("Expected indexes to be rebuilt on load")
+	}
+}
+
+func TestLookups(t *testing.T) {
+	ds := setupTestDataset(t)
+
+	if electric := ds.PokemonByType("electric"); !reflect.DeepEqual(electric, []string{"pikachu", "raichu"}) {
+		t.Errorf("Unexpected electric pokemon: %v", electric)
+	}
+	if gen4 := ds.PokemonByGeneration(4); !reflect.DeepEqual(gen4, []string{"shellos"}) {
+		t.Errorf("Unexpected generation 4 pokemon: %v", gen4)
+	}
+	if fast := ds.PokemonByStat("speed", 100); !reflect.DeepEqual(fast, []string{"raichu", "charizard"}) {
+		t.Errorf("Unexpected fast pokemon: %v", fast)
+	}
+}
+
+func TestClient(t *testing.T) {
+	client := NewClient(setupTestDataset(t), logger.New(logger.NONE))
+	ctx := context.Background()
+
+	pokemon, err := client.FetchPokemon(ctx, "bulbasaur")
+	if err != nil {
+		t.Fatalf("FetchPokemon failed: %v", err)
+	}
+	if pokemon.ID != 1 || len(pokemon.Stats) != 6 || pokemon.Types[1].Type.Name != "poison" || pokemon.Species.Name != "bulbasaur" {
+		t.Errorf("Unexpected pokemon: %+v", pokemon)
+	}
+
+	species, err := client.FetchPokemonSpecies(ctx, "bulbasaur")
+	if err != nil || species.CaptureRate != 45 || species.DefaultPokemon() != "bulbasaur" {
+		t.Errorf("Unexpected species: %+v, %v", species, err)
+	}
+
+	area, err := client.FetchAreaPokemon(ctx, "eterna-forest-area")
+	if err != nil || area.Location.Name != "eterna-forest" || len(area.PokemonEncounters) != 4 {
+		t.Errorf("Unexpected area: %+v, %v", area, err)
+	}
+
+	if _, err := client.FetchPokemon(ctx, "mew"); !errors.Is(err, pokeapi.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for missing pokemon, got %v", err)
+	}
+}
+
+func TestClientListLocations(t *testing.T) {
+	client := NewClient(setupTestDataset(t), logger.New(logger.NONE))
+	ctx := context.Background()
+
+	pageURL := apiURL + "/location-area?offset=0&limit=2"
+	first, err := client.ListLocations(ctx, &pageURL)
+	if err != nil {
+		t.Fatalf("ListLocations failed: %v", err)
+	}
+	if first.Count != 3 || len(first.Results) != 2 || first.Previous != nil || first.Next == nil {
+		t.Fatalf("Unexpected first page: %+v", first)
+	}
+	if first.Results[0].Name != "canalave-city-area" {
+		t.Errorf("Expected areas in id order, got %+v", first.Results)
+	}
+
+	second, err := client.ListLocations(ctx, first.Next)
+	if err != nil {
+		t.Fatalf("ListLocations failed on next page: %v", err)
+	}
+	if len(second.Results) != 1 || second.Next != nil || second.Previous == nil {
+		t.Errorf("Unexpected second page: %+v", second)
+	}
+}
+
+func TestClientListResources(t *testing.T) {
+	client := NewClient(setupTestDataset(t), logger.New(logger.NONE))
+
+	var names []string
+	for res, err := range pokeapi.Resources(context.Background(), client, "pokemon", 5) {
+		if err != nil {
+			t.Fatalf("Resources failed: %v", err)
+		}
+		names = append(names, res.Name)
+	}
+	if len(names) != 17 || names[0] != "bulbasaur" || names[16] != "shellos" {
+		t.Errorf("Expected all 17 pokemon in id order, got %v", names)
+	}
+
+	if _, err := client.ListResources(context.Background(), "berry", 0, 20); !errors.Is(err, pokeapi.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for an endpoint missing from the dataset, got %v", err)
+	}
+}
+
+func TestClientTypesMovesAbilities(t *testing.T) {
+	client := NewClient(setupTestDataset(t), logger.New(logger.NONE))
+	ctx := context.Background()
+
+	electric, err := client.FetchType(ctx, "electric")
+	if err != nil {
+		t.Fatalf("FetchType failed: %v", err)
+	}
+	relations := electric.DamageRelations
+	if len(relations.NoDamageTo) != 1 || relations.NoDamageTo[0].Name != "ground" {
+		t.Errorf("Expected electric to do no damage to ground, got %+v", relations.NoDamageTo)
+	}
+	if len(relations.DoubleDamageFrom) != 1 || relations.DoubleDamageFrom[0].Name != "ground" {
+		t.Errorf("Expected electric to take double damage from ground, got %+v", relations.DoubleDamageFrom)
+	}
+	if len(electric.Pokemon) != 2 || len(electric.Moves) != 2 {
+		t.Errorf("Expected 2 electric pokemon and moves, got %+v and %+v", electric.Pokemon, electric.Moves)
+	}
+
+	thunderbolt, err := client.FetchMove(ctx, "thunderbolt")
+	if err != nil {
+		t.Fatalf("FetchMove failed: %v", err)
+	}
+	if *thunderbolt.Power != 90 || *thunderbolt.PP != 15 || thunderbolt.Type.Name != "electric" || thunderbolt.DamageClass.Name != "special" {
+		t.Errorf("Unexpected thunderbolt: %+v", thunderbolt)
+	}
+	if effect := thunderbolt.ShortEffect(); effect != "Has a 10% chance to paralyze the target." {
+		t.Errorf("Unexpected thunderbolt effect: %q", effect)
+	}
+
+	splash, err := client.FetchMove(ctx, "splash")
+	if err != nil || splash.Power != nil || splash.Accuracy != nil {
+		t.Errorf("Expected splash to have no power or accuracy, got %+v, %v", splash, err)
+	}
+
+	static, err := client.FetchAbility(ctx, "static")
+	if err != nil {
+		t.Fatalf("FetchAbility failed: %v", err)
+	}
+	if effect, ok := pokeapi.EnglishEffect(static.EffectEntries); !ok || effect.ShortEffect == "" {
+		t.Errorf("Expected an English effect for static, got %+v", static.EffectEntries)
+	}
+	if len(static.Pokemon) != 2 || static.Pokemon[0].Pokemon.Name != "pikachu" {
+		t.Errorf("Unexpected static pokemon: %+v", static.Pokemon)
+	}
+
+	if _, err := client.FetchMove(ctx, "hyper-beam"); !errors.Is(err, pokeapi.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for missing move, got %v", err)
+	}
+}
+
+func TestClientEvolutionChain(t *testing.T) {
+	client := NewClient(setupTestDataset(t), logger.New(logger.NONE))
+	ctx := context.Background()
+
+	species, err := client.FetchPokemonSpecies(ctx, "ivysaur")
+	if err != nil {
+		t.Fatalf("FetchPokemonSpecies failed: %v", err)
+	}
+	id, err := species.EvolutionChain.ID()
+	if err != nil || id != 1 || species.EvolvesFromSpecies.Name != "bulbasaur" {
+		t.Fatalf("Unexpected ivysaur species: %+v, %v", species, err)
+	}
+
+	chain, err := client.FetchEvolutionChain(ctx, id)
+	if err != nil {
+		t.Fatalf("FetchEvolutionChain failed: %v", err)
+	}
+	if chain.Chain.Species.Name != "bulbasaur" || len(chain.Chain.EvolutionDetails) != 0 {
+		t.Errorf("Expected the chain to start at bulbasaur, got %+v", chain.Chain)
+	}
+	venusaur, ok := chain.Chain.Find("venusaur")
+	if !ok || len(venusaur.EvolutionDetails) != 1 || venusaur.EvolutionDetails[0].String() != "level 32" {
+		t.Errorf("Unexpected venusaur link: %+v", venusaur)
+	}
+
+	// Pichu isn't in the test dump, so pikachu's chain starts at pikachu
+	pikachu, err := client.FetchEvolutionChain(ctx, 10)
+	if err != nil {
+		t.Fatalf("FetchEvolutionChain failed: %v", err)
+	}
+	raichu, ok := pikachu.Chain.Find("raichu")
+	if pikachu.Chain.Species.Name != "pikachu" || !ok || raichu.EvolutionDetails[0].String() != "use thunder-stone" {
+		t.Errorf("Unexpected pikachu chain: %+v", pikachu)
+	}
+
+	if _, err := client.FetchEvolutionChain(ctx, 999); !errors.Is(err, pokeapi.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for a missing chain, got %v", err)
+	}
+}
+
+func TestClientGrowthRate(t *testing.T) {
+	client := NewClient(setupTestDataset(t), logger.New(logger.NONE))
+
+	mediumSlow, err := client.FetchGrowthRate(context.Background(), "medium-slow")
+	if err != nil {
+		t.Fatalf("FetchGrowthRate failed: %v", err)
+	}
+	if len(mediumSlow.Levels) != pokeapi.MaxLevel || mediumSlow.ExperienceFor(pokeapi.MaxLevel) != 1059860 {
+		t.Errorf("Unexpected medium-slow levels: %+v", mediumSlow.Levels)
+	}
+	if mediumSlow.LevelFor(135) != 5 || len(mediumSlow.PokemonSpecies) != 7 {
+		t.Errorf("Unexpected medium-slow growth rate: %+v", mediumSlow.PokemonSpecies)
+	}
+
+	if _, err := client.FetchGrowthRate(context.Background(), "very-slow"); !errors.Is(err, pokeapi.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for a missing growth rate, got %v", err)
+	}
+}
+
+func TestClientNature(t *testing.T) {
+	client := NewClient(setupTestDataset(t), logger.New(logger.NONE))
+	ctx := context.Background()
+
+	modest, err := client.FetchNature(ctx, "modest")
+	if err != nil {
+		t.Fatalf("FetchNature failed: %v", err)
+	}
+	if modest.IncreasedStat.Name != "special-attack" || modest.DecreasedStat.Name != "attack" {
+		t.Errorf("Unexpected modest nature: %+v", modest)
+	}
+
+	// The dump marks neutral natures by raising and lowering the same stat
+	serious, err := client.FetchNature(ctx, "serious")
+	if err != nil || serious.IncreasedStat != nil || serious.DecreasedStat != nil {
+		t.Errorf("Expected serious to be neutral, got %+v, %v", serious, err)
+	}
+
+	natures, err := client.ListResources(ctx, "nature", 0, 200)
+	if err != nil || natures.Count != 25 || natures.Results[0].Name != "hardy" {
+		t.Errorf("Expected 25 natures starting with hardy, got %+v, %v", natures, err)
+	}
+}
+
+func TestClientSpeciesVarieties(t *testing.T) {
+	ds := &Dataset{
+		Species: map[string]*SpeciesRecord{"toxtricity": {ID: 849, Name: "toxtricity"}},
+		Pokemon: map[string]*PokemonRecord{
+			"toxtricity-amped":   {ID: 849, Name: "toxtricity-amped", Species: "toxtricity", IsDefault: true},
+			"toxtricity-low-key": {ID: 10184, Name: "toxtricity-low-key", Species: "toxtricity"},
+		},
+	}
+	client := NewClient(ds, logger.New(logger.NONE))
+
+	species, err := client.FetchPokemonSpecies(context.Background(), "toxtricity")
+	if err != nil {
+		t.Fatalf("FetchPokemonSpecies failed: %v", err)
+	}
+	if len(species.Varieties) != 2 || species.Varieties[1].Pokemon.Name != "toxtricity-low-key" {
+		t.Errorf("Expected both varieties in id order, got %+v", species.Varieties)
+	}
+	if name := species.DefaultPokemon(); name != "toxtricity-amped" {
+		t.Errorf("Expected toxtricity-amped as the default pokemon, got %s", name)
+	}
+}
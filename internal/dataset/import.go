@@ -0,0 +1,437 @@
+package dataset
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/sakuffo/pokedexcli/internal/logger"
+)
+
+// englishID is the local_language_id of English text in the prose tables.
+const englishID = "9"
+
+// csvDirs are the places Import looks for CSV files relative to the given directory,
+// so both a checkout of the PokeAPI repository and its data/v2/csv folder work.
+var csvDirs = []string{".", filepath.Join("data", "v2", "csv")}
+
+// Import reads the PokeAPI project's CSV data dump from dir and builds a Dataset.
+// Files describing moves, abilities, type efficacy, effect text and encounters are optional; the rest are required.
+func Import(dir string, lgr *logger.Logger) (*Dataset, error) {
+	csvDir, err := findCSVDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	lgr.Info("Importing PokeAPI dataset from %s", csvDir)
+
+	imp := &importer{dir: csvDir, logger: lgr}
+	ds := &Dataset{
+		Pokemon: make(map[string]*PokemonRecord),
+		Species: make(map[string]*SpeciesRecord),
+		Areas:   make(map[string]*AreaRecord),
+
+		Types:     make(map[string]*TypeRecord),
+		Moves:     make(map[string]*MoveRecord),
+		Abilities: make(map[string]*AbilityDetailRecord),
+
+		GrowthRates: make(map[string]*GrowthRateRecord),
+		Natures:     make(map[string]*NatureRecord),
+	}
+
+	// Lookup tables from ids to identifiers
+	generations := imp.identifiers("generations", true)
+	growthRates := imp.identifiers("growth_rates", true)
+	stats := imp.identifiers("stats", true)
+	types := imp.identifiers("types", true)
+	abilities := imp.identifiers("abilities", false)
+	moves := imp.identifiers("moves", false)
+	locations := imp.identifiers("locations", false)
+	damageClasses := imp.identifiers("move_damage_classes", false)
+
+	growthRatesByID := make(map[string]*GrowthRateRecord)
+	imp.each("growth_rates", true, func(row map[string]string) {
+		g := &GrowthRateRecord{
+			ID:      atoi(row["id"]),
+			Name:    row["identifier"],
+			Formula: row["formula"],
+		}
+		ds.GrowthRates[g.Name] = g
+		growthRatesByID[row["id"]] = g
+	})
+
+	// experience lists levels in order, but place them by level to be safe
+	imp.each("experience", false, func(row map[string]string) {
+		g, ok := growthRatesByID[row["growth_rate_id"]]
+		level := atoi(row["level"])
+		if !ok || level < 1 {
+			return
+		}
+		for len(g.Experience) < level {
+			g.Experience = append(g.Experience, 0)
+		}
+		g.Experience[level-1] = atoi(row["experience"])
+	})
+
+	imp.each("types", true, func(row map[string]string) {
+		ds.Types[row["identifier"]] = &TypeRecord{
+			ID:             atoi(row["id"]),
+			Name:           row["identifier"],
+			Generation:     atoi(row["generation_id"]),
+			GenerationName: generations[row["generation_id"]],
+			DamageClass:    damageClasses[row["damage_class_id"]],
+			Efficacy:       make(map[string]int),
+		}
+	})
+
+	imp.each("type_efficacy", false, func(row map[string]string) {
+		attacker, ok := ds.Types[types[row["damage_type_id"]]]
+		defender := types[row["target_type_id"]]
+		if !ok || defender == "" || row["damage_factor"] == "100" {
+			return
+		}
+		attacker.Efficacy[defender] = atoi(row["damage_factor"])
+	})
+
+	// Effect prose is keyed by effect id and shared between moves; keep English only
+	moveEffects := make(map[string][2]string)
+	imp.each("move_effect_prose", false, func(row map[string]string) {
+		if row["local_language_id"] == englishID {
+			moveEffects[row["move_effect_id"]] = [2]string{row["effect"], row["short_effect"]}
+		}
+	})
+
+	imp.each("moves", false, func(row map[string]string) {
+		effect := moveEffects[row["effect_id"]]
+		ds.Moves[row["identifier"]] = &MoveRecord{
+			ID:             atoi(row["id"]),
+			Name:           row["identifier"],
+			Type:           types[row["type_id"]],
+			Generation:     atoi(row["generation_id"]),
+			GenerationName: generations[row["generation_id"]],
+			DamageClass:    damageClasses[row["damage_class_id"]],
+			Power:          optionalInt(row["power"]),
+			PP:             optionalInt(row["pp"]),
+			Accuracy:       optionalInt(row["accuracy"]),
+			Priority:       atoi(row["priority"]),
+			EffectChance:   optionalInt(row["effect_chance"]),
+			Effect:         effect[0],
+			ShortEffect:    effect[1],
+		}
+	})
+
+	abilitiesByID := make(map[string]*AbilityDetailRecord)
+	imp.each("abilities", false, func(row map[string]string) {
+		a := &AbilityDetailRecord{
+			ID:             atoi(row["id"]),
+			Name:           row["identifier"],
+			Generation:     atoi(row["generation_id"]),
+			GenerationName: generations[row["generation_id"]],
+			IsMainSeries:   row["is_main_series"] == "1",
+		}
+		ds.Abilities[a.Name] = a
+		abilitiesByID[row["id"]] = a
+	})
+
+	imp.each("ability_prose", false, func(row map[string]string) {
+		if a, ok := abilitiesByID[row["ability_id"]]; ok && row["local_language_id"] == englishID {
+			a.Effect = row["effect"]
+			a.ShortEffect = row["short_effect"]
+		}
+	})
+
+	speciesByID := make(map[string]*SpeciesRecord)
+	evolvesFrom := make(map[*SpeciesRecord]string)
+	imp.each("pokemon_species", true, func(row map[string]string) {
+		s := &SpeciesRecord{
+			ID:               atoi(row["id"]),
+			Name:             row["identifier"],
+			Generation:       atoi(row["generation_id"]),
+			GenerationName:   generations[row["generation_id"]],
+			CaptureRate:      atoi(row["capture_rate"]),
+			BaseHappiness:    atoi(row["base_happiness"]),
+			GrowthRate:       growthRates[row["growth_rate_id"]],
+			EvolutionChainID: atoi(row["evolution_chain_id"]),
+			IsBaby:           row["is_baby"] == "1",
+			IsLegendary:      row["is_legendary"] == "1",
+			IsMythical:       row["is_mythical"] == "1",
+		}
+		ds.Species[s.Name] = s
+		speciesByID[row["id"]] = s
+		evolvesFrom[s] = row["evolves_from_species_id"]
+	})
+	for s, fromID := range evolvesFrom {
+		if from, ok := speciesByID[fromID]; ok {
+			s.EvolvesFrom = from.Name
+		}
+	}
+
+	triggers := imp.identifiers("evolution_triggers", false)
+	items := imp.identifiers("items", false)
+	imp.each("pokemon_evolution", false, func(row map[string]string) {
+		s, ok := speciesByID[row["evolved_species_id"]]
+		if !ok {
+			return
+		}
+		var partySpecies, tradeSpecies string
+		if party, ok := speciesByID[row["party_species_id"]]; ok {
+			partySpecies = party.Name
+		}
+		if trade, ok := speciesByID[row["trade_species_id"]]; ok {
+			tradeSpecies = trade.Name
+		}
+		s.Evolution = append(s.Evolution, EvolutionRecord{
+			Trigger:               triggers[row["evolution_trigger_id"]],
+			MinLevel:              atoi(row["minimum_level"]),
+			Item:                  items[row["trigger_item_id"]],
+			HeldItem:              items[row["held_item_id"]],
+			KnownMove:             moves[row["known_move_id"]],
+			KnownMoveType:         types[row["known_move_type_id"]],
+			Location:              locations[row["location_id"]],
+			MinHappiness:          atoi(row["minimum_happiness"]),
+			MinBeauty:             atoi(row["minimum_beauty"]),
+			MinAffection:          atoi(row["minimum_affection"]),
+			Gender:                atoi(row["gender_id"]),
+			RelativePhysicalStats: optionalInt(row["relative_physical_stats"]),
+			PartySpecies:          partySpecies,
+			PartyType:             types[row["party_type_id"]],
+			TradeSpecies:          tradeSpecies,
+			TimeOfDay:             row["time_of_day"],
+			NeedsOverworldRain:    row["needs_overworld_rain"] == "1",
+			TurnUpsideDown:        row["turn_upside_down"] == "1",
+		})
+	})
+
+	pokemonByID := make(map[string]*PokemonRecord)
+	imp.each("pokemon", true, func(row map[string]string) {
+		p := &PokemonRecord{
+			ID:             atoi(row["id"]),
+			Name:           row["identifier"],
+			Height:         atoi(row["height"]),
+			Weight:         atoi(row["weight"]),
+			BaseExperience: atoi(row["base_experience"]),
+			Order:          atoi(row["order"]),
+			IsDefault:      row["is_default"] == "1",
+		}
+		if species, ok := speciesByID[row["species_id"]]; ok {
+			p.Species = species.Name
+		}
+		ds.Pokemon[p.Name] = p
+		pokemonByID[row["id"]] = p
+	})
+
+	imp.each("pokemon_stats", true, func(row map[string]string) {
+		if p, ok := pokemonByID[row["pokemon_id"]]; ok {
+			p.Stats = append(p.Stats, StatRecord{
+				Name:   stats[row["stat_id"]],
+				Base:   atoi(row["base_stat"]),
+				Effort: atoi(row["effort"]),
+			})
+		}
+	})
+
+	// Neutral natures raise and lower the same stat in the dump
+	imp.each("natures", false, func(row map[string]string) {
+		n := &NatureRecord{ID: atoi(row["id"]), Name: row["identifier"]}
+		if row["increased_stat_id"] != row["decreased_stat_id"] {
+			n.IncreasedStat = stats[row["increased_stat_id"]]
+			n.DecreasedStat = stats[row["decreased_stat_id"]]
+		}
+		ds.Natures[n.Name] = n
+	})
+
+	typeSlots := make(map[*PokemonRecord]map[int]string)
+	imp.each("pokemon_types", true, func(row map[string]string) {
+		if p, ok := pokemonByID[row["pokemon_id"]]; ok {
+			if typeSlots[p] == nil {
+				typeSlots[p] = make(map[int]string)
+			}
+			typeSlots[p][atoi(row["slot"])] = types[row["type_id"]]
+		}
+	})
+	for p, slots := range typeSlots {
+		p.Types = inSlotOrder(slots)
+	}
+
+	imp.each("pokemon_abilities", false, func(row map[string]string) {
+		if p, ok := pokemonByID[row["pokemon_id"]]; ok {
+			p.Abilities = append(p.Abilities, AbilityRecord{
+				Name:     abilities[row["ability_id"]],
+				IsHidden: row["is_hidden"] == "1",
+				Slot:     atoi(row["slot"]),
+			})
+		}
+	})
+
+	// pokemon_moves lists every version group and learn method; keep each move once
+	seenMoves := make(map[*PokemonRecord]map[string]bool)
+	imp.each("pokemon_moves", false, func(row map[string]string) {
+		p, ok := pokemonByID[row["pokemon_id"]]
+		move := moves[row["move_id"]]
+		if !ok || move == "" {
+			return
+		}
+		if seenMoves[p] == nil {
+			seenMoves[p] = make(map[string]bool)
+		}
+		if !seenMoves[p][move] {
+			seenMoves[p][move] = true
+			p.Moves = append(p.Moves, move)
+		}
+	})
+
+	areasByID := make(map[string]*AreaRecord)
+	imp.each("location_areas", false, func(row map[string]string) {
+		location := locations[row["location_id"]]
+		a := &AreaRecord{
+			ID:       atoi(row["id"]),
+			Name:     areaName(location, row["identifier"]),
+			Location: location,
+		}
+		ds.Areas[a.Name] = a
+		areasByID[row["id"]] = a
+	})
+
+	seenEncounters := make(map[*AreaRecord]map[string]bool)
+	imp.each("encounters", false, func(row map[string]string) {
+		a, okArea := areasByID[row["location_area_id"]]
+		p, okPokemon := pokemonByID[row["pokemon_id"]]
+		if !okArea || !okPokemon {
+			return
+		}
+		if seenEncounters[a] == nil {
+			seenEncounters[a] = make(map[string]bool)
+		}
+		if !seenEncounters[a][p.Name] {
+			seenEncounters[a][p.Name] = true
+			a.Pokemon = append(a.Pokemon, p.Name)
+		}
+	})
+
+	if imp.err != nil {
+		return nil, imp.err
+	}
+
+	for _, a := range ds.Areas {
+		ds.AreaOrder = append(ds.AreaOrder, a.Name)
+	}
+	sort.Slice(ds.AreaOrder, func(i, j int) bool {
+		return ds.Areas[ds.AreaOrder[i]].ID < ds.Areas[ds.AreaOrder[j]].ID
+	})
+
+	ds.buildIndexes()
+	lgr.Info("Imported %d pokemon, %d species, %d location areas, %d types, %d moves and %d abilities",
+		len(ds.Pokemon), len(ds.Species), len(ds.Areas), len(ds.Types), len(ds.Moves), len(ds.Abilities))
+	return ds, nil
+}
+
+// importer reads CSV files from one directory, remembering the first error so
+// the import code can read file after file without checking each one.
+type importer struct {
+	dir    string
+	logger *logger.Logger
+	err    error
+}
+
+// each calls fn for every row of name.csv, keyed by the header. A missing optional
+// file is logged and skipped; a missing required file fails the import.
+func (imp *importer) each(name string, required bool, fn func(row map[string]string)) {
+	if imp.err != nil {
+		return
+	}
+
+	path := filepath.Join(imp.dir, name+".csv")
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) && !required {
+			imp.logger.Info("Optional dataset file %s.csv not found, skipping", name)
+			return
+		}
+		imp.err = fmt.Errorf("failed to open %s.csv: %w", name, err)
+		return
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	header, err := reader.Read()
+	if err != nil {
+		imp.err = fmt.Errorf("failed to read header of %s.csv: %w", name, err)
+		return
+	}
+
+	row := make(map[string]string, len(header))
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			imp.err = fmt.Errorf("failed to read %s.csv: %w", name, err)
+			return
+		}
+		for i, column := range header {
+			row[column] = record[i]
+		}
+		fn(row)
+	}
+}
+
+// identifiers maps the id column of name.csv to its identifier column.
+func (imp *importer) identifiers(name string, required bool) map[string]string {
+	ids := make(map[string]string)
+	imp.each(name, required, func(row map[string]string) {
+		ids[row["id"]] = row["identifier"]
+	})
+	return ids
+}
+
+func findCSVDir(dir string) (string, error) {
+	for _, candidate := range csvDirs {
+		path := filepath.Join(dir, candidate)
+		if _, err := os.Stat(filepath.Join(path, "pokemon.csv")); err == nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("no pokemon.csv found in %s or %s", dir, filepath.Join(dir, csvDirs[1]))
+}
+
+// areaName builds the PokeAPI name of a location area, e.g. "canalave-city-area".
+func areaName(location, area string) string {
+	if area == "" {
+		return location + "-area"
+	}
+	return location + "-" + area
+}
+
+func inSlotOrder(slots map[int]string) []string {
+	keys := make([]int, 0, len(slots))
+	for slot := range slots {
+		keys = append(keys, slot)
+	}
+	sort.Ints(keys)
+
+	ordered := make([]string, len(keys))
+	for i, slot := range keys {
+		ordered[i] = slots[slot]
+	}
+	return ordered
+}
+
+// optionalInt parses a nullable CSV integer column, returning nil for blanks.
+func optionalInt(s string) *int {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return nil
+	}
+	return &n
+}
+
+// atoi parses a CSV integer column, treating blanks as zero.
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
@@ -0,0 +1,72 @@
+package party
+
+import (
+	"time"
+
+	"github.com/sakuffo/pokedexcli/internal/pokeapi"
+)
+
+// EventEvolved is the history event recorded by Evolve.
+const EventEvolved = "evolved"
+
+// Species returns the name of the member's species, which is what evolution chains list.
+func (p *PartyPokemon) Species() string {
+	if p.BasePokemon.Species.Name != "" {
+		return p.BasePokemon.Species.Name
+	}
+	return p.BasePokemon.Name
+}
+
+// NextEvolution finds the stage in chain the member can evolve into right now.
+// With an empty item it checks level-up evolutions against the member's level;
+// otherwise it checks evolutions triggered by using item. Evolutions with conditions
+// the party doesn't track, such as friendship or time of day, never match.
+func (p *PartyPokemon) NextEvolution(chain pokeapi.EvolutionChain, item string) (species string, detail pokeapi.EvolutionDetail, ok bool) {
+	current, found := chain.Chain.Find(p.Species())
+	if !found {
+		return "", pokeapi.EvolutionDetail{}, false
+	}
+
+	for _, next := range current.EvolvesTo {
+		for _, d := range next.EvolutionDetails {
+			if p.meets(d, item) {
+				return next.Species.Name, d, true
+			}
+		}
+	}
+	return "", pokeapi.EvolutionDetail{}, false
+}
+
+// meets reports whether d is satisfied by the member's level or by using item.
+func (p *PartyPokemon) meets(d pokeapi.EvolutionDetail, item string) bool {
+	// Anything beyond the trigger, level and item is a condition we can't check
+	rest := d
+	rest.Trigger, rest.MinLevel, rest.Item = pokeapi.NamedAPIResource{}, nil, nil
+	if rest != (pokeapi.EvolutionDetail{}) {
+		return false
+	}
+
+	switch d.Trigger.Name {
+	case "level-up":
+		return item == "" && d.MinLevel != nil && p.Level >= *d.MinLevel
+	case "use-item":
+		return item != "" && d.Item != nil && d.Item.Name == item
+	default:
+		return false
+	}
+}
+
+// Evolve turns the member into evolved, keeping its identity, nickname, level and
+// caught date, and records the evolution in its history.
+func (p *PartyPokemon) Evolve(evolved pokeapi.Pokemon, reason string) {
+	p.History = append(p.History, HistoryEvent{
+		At:     time.Now(),
+		Event:  EventEvolved,
+		Level:  p.Level,
+		From:   p.BasePokemon.Name,
+		To:     evolved.Name,
+		Reason: reason,
+	})
+	p.BasePokemon = evolved
+	p.RecalculateStats()
+}
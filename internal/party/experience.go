@@ -0,0 +1,37 @@
+package party
+
+import "github.com/sakuffo/pokedexcli/internal/pokeapi"
+
+// ExperienceYield is the experience earned for defeating or catching a Pokemon
+// with the given base experience at level.
+func ExperienceYield(baseExperience, level int) int {
+	return baseExperience * level / 7
+}
+
+// GainExperience adds amount experience using rate, the member's species growth
+// rate, and levels it up through every threshold it passes. Stats are recalculated
+// on level-up. It returns the number of levels gained.
+func (p *PartyPokemon) GainExperience(amount int, rate pokeapi.GrowthRate) int {
+	// Members start at their level with no experience; count it from there
+	p.Experience = max(p.Experience, rate.ExperienceFor(p.Level)) + max(amount, 0)
+	if maxExperience := rate.ExperienceFor(pokeapi.MaxLevel); maxExperience > 0 {
+		p.Experience = min(p.Experience, maxExperience)
+	}
+
+	level := max(rate.LevelFor(p.Experience), p.Level)
+	gained := level - p.Level
+	if gained > 0 {
+		p.Level = level
+		p.RecalculateStats()
+	}
+	return gained
+}
+
+// ExperienceToNextLevel returns how much more experience the member needs for
+// its next level under rate, or 0 at the maximum level.
+func (p *PartyPokemon) ExperienceToNextLevel(rate pokeapi.GrowthRate) int {
+	if p.Level >= pokeapi.MaxLevel {
+		return 0
+	}
+	return max(rate.ExperienceFor(p.Level+1)-max(p.Experience, rate.ExperienceFor(p.Level)), 0)
+}
@@ -3,6 +3,7 @@ package party
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 )
 
@@ -11,6 +12,9 @@ type Party struct {
 	mu      sync.Mutex
 }
 
+// AddMember adds pokemon to the party. Members are addressed by nickname, so
+// nicknames must be unique; the same species may appear more than once, e.g.
+// after an evolution.
 func (p *Party) AddMember(pokemon *PartyPokemon) error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
@@ -20,8 +24,8 @@ func (p *Party) AddMember(pokemon *PartyPokemon) error {
 	}
 
 	for _, member := range p.Members {
-		if member.BasePokemon.Name == pokemon.BasePokemon.Name {
-			return fmt.Errorf("Pokemon %s is already in the party", pokemon.BasePokemon.Name)
+		if member.Nickname == pokemon.Nickname {
+			return fmt.Errorf("Pokemon %s is already in the party", pokemon.Nickname)
 		}
 	}
 
@@ -29,12 +33,13 @@ func (p *Party) AddMember(pokemon *PartyPokemon) error {
 	return nil
 }
 
+// RemoveMember removes the member nicknamed name.
 func (p *Party) RemoveMember(name string) error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
 	for i, member := range p.Members {
-		if member.BasePokemon.Name == name {
+		if member.Nickname == name {
 			p.Members[i] = p.Members[len(p.Members)-1]
 			p.Members = p.Members[:len(p.Members)-1]
 			return nil
@@ -47,17 +52,24 @@ func (p *Party) ListMembers() []*PartyPokemon {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
+	// Copy the members too, so callers can't change the party through the result
 	result := make([]*PartyPokemon, len(p.Members))
-	copy(result, p.Members)
+	for i, member := range p.Members {
+		clone := *member
+		clone.History = slices.Clone(member.History)
+		result[i] = &clone
+	}
 	return result
 }
 
+// GetMember returns the member nicknamed name, which keeps working after the
+// member evolves into another species.
 func (p *Party) GetMember(name string) (*PartyPokemon, bool) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
 	for _, member := range p.Members {
-		if member.BasePokemon.Name == name {
+		if member.Nickname == name {
 			return member, true
 		}
 	}
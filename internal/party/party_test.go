@@ -1,6 +1,7 @@
 package party
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -161,6 +162,31 @@ func TestGetMember(t *testing.T) {
 	}
 }
 
+func TestMembersByNicknameAfterEvolving(t *testing.T) {
+	party := &Party{Members: make([]*PartyPokemon, 0)}
+	raichu := NewPartyPokemon(pokeapi.Pokemon{Name: "raichu", ID: 26})
+	pikachu := NewPartyPokemon(pokeapi.Pokemon{Name: "pikachu", ID: 25})
+	_ = party.AddMember(raichu)
+	_ = party.AddMember(pikachu)
+
+	// Evolving into a species that's already in the party keeps both reachable
+	pikachu.Evolve(pokeapi.Pokemon{Name: "raichu", ID: 26}, "use thunder-stone")
+
+	if member, found := party.GetMember("pikachu"); !found || member != pikachu {
+		t.Errorf("Expected to find the evolved member by its nickname, got %+v", member)
+	}
+	if member, found := party.GetMember("raichu"); !found || member != raichu {
+		t.Errorf("Expected 'raichu' to still be the original raichu, got %+v", member)
+	}
+
+	if err := party.RemoveMember("pikachu"); err != nil {
+		t.Fatalf("Failed to remove the evolved member: %v", err)
+	}
+	if len(party.Members) != 1 || party.Members[0] != raichu {
+		t.Errorf("Expected only the original raichu to remain, got %+v", party.Members)
+	}
+}
+
 func TestIsFull(t *testing.T) {
 	party := &Party{Members: make([]*PartyPokemon, 0)}
 	if party.IsFull() {
@@ -183,3 +209,232 @@ func TestIsFull(t *testing.T) {
 		t.Errorf("Party with 6 members not reported as full")
 	}
 }
+
+func TestNextEvolutionAndEvolve(t *testing.T) {
+	var chain pokeapi.EvolutionChain
+	err := json.Unmarshal([]byte(`{"id": 10, "chain": {"species": {"name": "pichu"}, "evolves_to": [
+		{"species": {"name": "pikachu"}, "evolution_details": [{"trigger": {"name": "level-up"}, "min_happiness": 220}], "evolves_to": [
+			{"species": {"name": "raichu"}, "evolution_details": [{"trigger": {"name": "use-item"}, "item": {"name": "thunder-stone"}}]}]}]}}`), &chain)
+	if err != nil {
+		t.Fatalf("Invalid test JSON: %v", err)
+	}
+
+	pichu := NewPartyPokemon(pokeapi.Pokemon{Name: "pichu"})
+	pichu.Level = 50
+	if _, _, ok := pichu.NextEvolution(chain, ""); ok {
+		t.Errorf("Expected pichu not to evolve without friendship tracking")
+	}
+
+	pikachu := NewPartyPokemon(pokeapi.Pokemon{Name: "pikachu"})
+	pikachu.Nickname = "sparky"
+	if _, _, ok := pikachu.NextEvolution(chain, "fire-stone"); ok {
+		t.Errorf("Expected pikachu not to evolve with the wrong item")
+	}
+	species, detail, ok := pikachu.NextEvolution(chain, "thunder-stone")
+	if !ok || species != "raichu" || detail.String() != "use thunder-stone" {
+		t.Fatalf("Expected pikachu to evolve into raichu with a thunder-stone, got %q, %v", species, ok)
+	}
+
+	id, caughtAt := pikachu.InstanceID, pikachu.CaughtAt
+	var raichu pokeapi.Pokemon
+	if err := json.Unmarshal([]byte(`{"name": "raichu", "stats": [{"base_stat": 60, "stat": {"name": "hp"}}]}`), &raichu); err != nil {
+		t.Fatalf("Invalid test JSON: %v", err)
+	}
+	pikachu.IVs = Stats{}
+	pikachu.Evolve(raichu, detail.String())
+
+	if pikachu.BasePokemon.Name != "raichu" || pikachu.InstanceID != id || pikachu.Nickname != "sparky" || !pikachu.CaughtAt.Equal(caughtAt) {
+		t.Errorf("Expected only the base pokemon to change, got %+v", pikachu)
+	}
+	// 2*60*5/100 + 5 + 10 for raichu's base HP of 60 at level 5
+	if pikachu.CurrentStats.HP != 21 {
+		t.Errorf("Expected stats to be recomputed for raichu, got %+v", pikachu.CurrentStats)
+	}
+	if len(pikachu.History) != 1 {
+		t.Fatalf("Expected one history event, got %+v", pikachu.History)
+	}
+	if event := pikachu.History[0]; event.Event != EventEvolved || event.From != "pikachu" || event.To != "raichu" || event.Reason != "use thunder-stone" {
+		t.Errorf("Unexpected history event: %+v", event)
+	}
+	if _, _, ok := pikachu.NextEvolution(chain, "thunder-stone"); ok {
+		t.Errorf("Expected raichu to be the last stage")
+	}
+}
+
+func TestNextEvolutionByLevel(t *testing.T) {
+	var chain pokeapi.EvolutionChain
+	err := json.Unmarshal([]byte(`{"chain": {"species": {"name": "bulbasaur"}, "evolves_to": [
+		{"species": {"name": "ivysaur"}, "evolution_details": [{"trigger": {"name": "level-up"}, "min_level": 16}]}]}}`), &chain)
+	if err != nil {
+		t.Fatalf("Invalid test JSON: %v", err)
+	}
+
+	bulbasaur := NewPartyPokemon(pokeapi.Pokemon{Name: "bulbasaur"})
+	bulbasaur.Level = 15
+	if _, _, ok := bulbasaur.NextEvolution(chain, ""); ok {
+		t.Errorf("Expected bulbasaur not to evolve below level 16")
+	}
+	bulbasaur.Level = 16
+	if species, _, ok := bulbasaur.NextEvolution(chain, ""); !ok || species != "ivysaur" {
+		t.Errorf("Expected bulbasaur to evolve into ivysaur at level 16, got %q, %v", species, ok)
+	}
+	if _, _, ok := bulbasaur.NextEvolution(chain, "rare-candy"); ok {
+		t.Errorf("Expected items not to trigger level-up evolutions")
+	}
+}
+
+func TestGainExperience(t *testing.T) {
+	// medium growth: level n needs n^3 experience
+	var rate pokeapi.GrowthRate
+	for level := 1; level <= pokeapi.MaxLevel; level++ {
+		experience := level * level * level
+		if level == 1 {
+			experience = 0
+		}
+		rate.Levels = append(rate.Levels, pokeapi.GrowthRateExperienceLevel{Level: level, Experience: experience})
+	}
+
+	var pikachu pokeapi.Pokemon
+	if err := json.Unmarshal([]byte(`{"name": "pikachu", "stats": [{"base_stat": 35, "stat": {"name": "hp"}}, {"base_stat": 90, "stat": {"name": "speed"}}]}`), &pikachu); err != nil {
+		t.Fatalf("Invalid test JSON: %v", err)
+	}
+	member := NewPartyPokemon(pikachu)
+	member.IVs = Stats{}
+	member.RecalculateStats()
+	if member.Level != DefaultLevel || member.CurrentStats.HP != 18 || member.CurrentStats.Speed != 14 {
+		t.Fatalf("Unexpected new member: %+v", member)
+	}
+
+	// New members have no experience yet; it starts from their level's threshold
+	if member.ExperienceToNextLevel(rate) != 216-125 {
+		t.Errorf("Expected %d experience to level 6, got %d", 216-125, member.ExperienceToNextLevel(rate))
+	}
+	if gained := member.GainExperience(90, rate); gained != 0 || member.Experience != 215 {
+		t.Errorf("Expected no level-up one short of level 6, got %d levels and %d experience", gained, member.Experience)
+	}
+
+	// One big award crosses several thresholds at once
+	if gained := member.GainExperience(4096-215, rate); gained != 11 || member.Level != 16 {
+		t.Errorf("Expected to reach level 16 gaining 11 levels, got level %d gaining %d", member.Level, gained)
+	}
+	if member.CurrentStats.HP != 2*35*16/100+16+10 || member.CurrentStats.Speed != 2*90*16/100+5 {
+		t.Errorf("Expected stats to be recalculated for level 16, got %+v", member.CurrentStats)
+	}
+
+	if gained := member.GainExperience(10000000, rate); member.Level != pokeapi.MaxLevel || gained != 84 || member.Experience != 1000000 {
+		t.Errorf("Expected to stop at level 100, got level %d with %d experience", member.Level, member.Experience)
+	}
+	if member.ExperienceToNextLevel(rate) != 0 {
+		t.Errorf("Expected no experience needed at the maximum level")
+	}
+}
+
+func TestCalculateStats(t *testing.T) {
+	// The worked example from the main series games' stat formula: a level 78
+	// adamant Garchomp with these IVs and EVs
+	var garchomp pokeapi.Pokemon
+	err := json.Unmarshal([]byte(`{"name": "garchomp", "stats": [
+		{"base_stat": 108, "stat": {"name": "hp"}}, {"base_stat": 130, "stat": {"name": "attack"}},
+		{"base_stat": 95, "stat": {"name": "defense"}}, {"base_stat": 80, "stat": {"name": "special-attack"}},
+		{"base_stat": 85, "stat": {"name": "special-defense"}}, {"base_stat": 102, "stat": {"name": "speed"}}]}`), &garchomp)
+	if err != nil {
+		t.Fatalf("Invalid test JSON: %v", err)
+	}
+
+	ivs := Stats{HP: 24, Attack: 12, Defense: 30, SpecialAttack: 16, SpecialDefense: 23, Speed: 5}
+	evs := Stats{HP: 74, Attack: 190, Defense: 91, SpecialAttack: 48, SpecialDefense: 84, Speed: 23}
+	adamant := &Nature{Name: "adamant", Increased: "attack", Decreased: "special-attack"}
+
+	got := calculateStats(garchomp, 78, ivs, evs, adamant)
+	want := Stats{HP: 289, Attack: 278, Defense: 193, SpecialAttack: 135, SpecialDefense: 171, Speed: 171}
+	if got != want {
+		t.Errorf("Expected %+v, got %+v", want, got)
+	}
+
+	// Without a nature nothing is raised or lowered
+	if neutral := calculateStats(garchomp, 78, ivs, evs, nil); neutral.Attack != 253 || neutral.SpecialAttack != 151 {
+		t.Errorf("Unexpected neutral stats: %+v", neutral)
+	}
+}
+
+func TestNewPartyPokemonIVs(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		ivs := NewPartyPokemon(pokeapi.Pokemon{Name: "pikachu"}).IVs
+		for _, name := range statNames {
+			if iv := ivs.Get(name); iv < 0 || iv > MaxIV {
+				t.Fatalf("IV for %s out of range: %d", name, iv)
+			}
+		}
+	}
+}
+
+func TestGainEffort(t *testing.T) {
+	var gyarados pokeapi.Pokemon
+	err := json.Unmarshal([]byte(`{"name": "gyarados", "stats": [{"base_stat": 95, "effort": 0, "stat": {"name": "hp"}}, {"base_stat": 125, "effort": 2, "stat": {"name": "attack"}}]}`), &gyarados)
+	if err != nil {
+		t.Fatalf("Invalid test JSON: %v", err)
+	}
+
+	member := NewPartyPokemon(gyarados)
+	attack := member.CurrentStats.Attack
+	for i := 0; i < 200; i++ {
+		member.GainEffort(gyarados)
+	}
+	if member.EVs.Attack != MaxStatEV || member.EVs.HP != 0 {
+		t.Errorf("Expected attack EVs to stop at %d, got %+v", MaxStatEV, member.EVs)
+	}
+	if member.CurrentStats.Attack <= attack {
+		t.Errorf("Expected EVs to raise attack above %d, got %d", attack, member.CurrentStats.Attack)
+	}
+
+	// The total cap applies across stats
+	member.EVs = Stats{HP: 252, Defense: 252}
+	for i := 0; i < 5; i++ {
+		member.GainEffort(gyarados)
+	}
+	if member.EVs.Total() != MaxTotalEV || member.EVs.Attack != 6 {
+		t.Errorf("Expected EVs to stop at %d in total, got %+v", MaxTotalEV, member.EVs)
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	// Members as saved before IVs and natures, including one from the old catch
+	// bug with no nickname and level 0
+	var members []*PartyPokemon
+	err := json.Unmarshal([]byte(`[
+		{"nickname": "pikachu", "level": 5, "current_stats": {"hp": 35, "attack": 55, "defense": 40, "special_attack": 50, "special_defense": 50, "speed": 90},
+			"base_pokemon": {"name": "pikachu", "stats": [{"base_stat": 35, "stat": {"name": "hp"}}, {"base_stat": 90, "stat": {"name": "speed"}}]}},
+		{"nickname": "", "level": 0, "current_stats": {"hp": 0},
+			"base_pokemon": {"name": "golduck", "stats": [{"base_stat": 80, "stat": {"name": "hp"}}]}}]`), &members)
+	if err != nil {
+		t.Fatalf("Invalid test JSON: %v", err)
+	}
+
+	for _, member := range members {
+		member.Migrate()
+		if member.IVs == (Stats{}) {
+			t.Errorf("Expected %s to be given IVs", member.Nickname)
+		}
+		if member.Nature != nil {
+			t.Errorf("Expected %s to stay neutral, got %+v", member.Nickname, member.Nature)
+		}
+		if want := calculateStats(member.BasePokemon, member.Level, member.IVs, member.EVs, nil); member.CurrentStats != want {
+			t.Errorf("Expected %s's stats to be recalculated to %+v, got %+v", member.Nickname, want, member.CurrentStats)
+		}
+	}
+
+	// (2*35 + IV)*5/100 + 15 can't reach the base stat of 35 it used to show
+	if pikachu := members[0]; pikachu.CurrentStats.HP > 20 || pikachu.Level != 5 {
+		t.Errorf("Unexpected migrated pikachu: level %d, %+v", pikachu.Level, pikachu.CurrentStats)
+	}
+	if golduck := members[1]; golduck.Nickname != "golduck" || golduck.Level != 1 {
+		t.Errorf("Expected golduck to get a nickname and level 1, got %q level %d", golduck.Nickname, golduck.Level)
+	}
+
+	// Members that already have IVs keep them
+	ivs := members[0].IVs
+	members[0].Migrate()
+	if members[0].IVs != ivs {
+		t.Errorf("Expected IVs %+v to be kept, got %+v", ivs, members[0].IVs)
+	}
+}
@@ -0,0 +1,129 @@
+package party
+
+import (
+	"math/rand/v2"
+
+	"github.com/sakuffo/pokedexcli/internal/pokeapi"
+)
+
+const (
+	// MaxIV is the highest individual value of a stat.
+	MaxIV = 31
+	// MaxStatEV and MaxTotalEV cap effort values per stat and across all stats.
+	MaxStatEV  = 252
+	MaxTotalEV = 510
+)
+
+// statNames are the PokeAPI names of the stats, in the order they're shown.
+var statNames = []string{"hp", "attack", "defense", "special-attack", "special-defense", "speed"}
+
+// Get returns the stat called name, or 0 for an unknown stat.
+func (s Stats) Get(name string) int {
+	if field := s.field(name); field != nil {
+		return *field
+	}
+	return 0
+}
+
+// Total returns the sum of all stats.
+func (s Stats) Total() int {
+	return s.HP + s.Attack + s.Defense + s.SpecialAttack + s.SpecialDefense + s.Speed
+}
+
+func (s *Stats) field(name string) *int {
+	switch name {
+	case "hp":
+		return &s.HP
+	case "attack":
+		return &s.Attack
+	case "defense":
+		return &s.Defense
+	case "special-attack":
+		return &s.SpecialAttack
+	case "special-defense":
+		return &s.SpecialDefense
+	case "speed":
+		return &s.Speed
+	default:
+		return nil
+	}
+}
+
+func randomIVs() Stats {
+	var ivs Stats
+	for _, name := range statNames {
+		*ivs.field(name) = rand.IntN(MaxIV + 1)
+	}
+	return ivs
+}
+
+// SetNature gives the member a nature and recalculates its stats.
+func (p *PartyPokemon) SetNature(nature *Nature) {
+	p.Nature = nature
+	p.RecalculateStats()
+}
+
+// GainEffort adds the effort values for defeating or catching defeated, up to
+// MaxStatEV per stat and MaxTotalEV overall, and recalculates stats.
+func (p *PartyPokemon) GainEffort(defeated pokeapi.Pokemon) {
+	for _, stat := range defeated.Stats {
+		field := p.EVs.field(stat.Stat.Name)
+		if field == nil {
+			continue
+		}
+		gain := min(stat.Effort, MaxStatEV-*field, MaxTotalEV-p.EVs.Total())
+		*field += max(gain, 0)
+	}
+	p.RecalculateStats()
+}
+
+// Migrate brings a member loaded from an older save up to date: members saved
+// before IVs existed get random ones, a missing nickname or a level below 1 is
+// repaired, and stats are recalculated with the current formula.
+func (p *PartyPokemon) Migrate() {
+	if p.IVs == (Stats{}) {
+		p.IVs = randomIVs()
+	}
+	if p.Nickname == "" {
+		p.Nickname = p.BasePokemon.Name
+	}
+	p.Level = max(p.Level, 1)
+	p.RecalculateStats()
+}
+
+// RecalculateStats derives CurrentStats from the member's base stats, level,
+// IVs, EVs and nature.
+func (p *PartyPokemon) RecalculateStats() {
+	p.CurrentStats = calculateStats(p.BasePokemon, p.Level, p.IVs, p.EVs, p.Nature)
+}
+
+// calculateStats implements the main series stat formulas.
+func calculateStats(base pokeapi.Pokemon, level int, ivs, evs Stats, nature *Nature) Stats {
+	var stats Stats
+
+	for _, stat := range base.Stats {
+		field := stats.field(stat.Stat.Name)
+		if field == nil {
+			continue
+		}
+
+		value := (2*stat.BaseStat + ivs.Get(stat.Stat.Name) + evs.Get(stat.Stat.Name)/4) * level / 100
+		if stat.Stat.Name == "hp" {
+			*field = value + level + 10
+			continue
+		}
+
+		value += 5
+		if nature != nil {
+			switch stat.Stat.Name {
+			case nature.Increased:
+				value = value * 110 / 100
+			case nature.Decreased:
+				value = value * 90 / 100
+			}
+		}
+		*field = value
+	}
+
+	return stats
+}
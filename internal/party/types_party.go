@@ -15,65 +15,74 @@ type PartyPokemon struct {
 	Experience int       `json:"experience"`
 	CaughtAt   time.Time `json:"caught_at"`
 
-	// Current stats (calculated from base stats)
-	CurrentStats struct {
-		HP             int `json:"hp"`
-		Attack         int `json:"attack"`
-		Defense        int `json:"defense"`
-		SpecialAttack  int `json:"special_attack"`
-		SpecialDefense int `json:"special_defense"`
-		Speed          int `json:"speed"`
-	} `json:"current_stats"`
+	// Current stats (calculated from base stats, level, IVs, EVs and nature)
+	CurrentStats Stats `json:"current_stats"`
+
+	// Individual values (0-31) rolled when caught and effort values earned since
+	IVs Stats `json:"ivs"`
+	EVs Stats `json:"evs"`
+	// Nature is nil for members caught before natures, which counts as neutral
+	Nature *Nature `json:"nature,omitempty"`
 
 	// Reference to base Pokemon
 	BasePokemon pokeapi.Pokemon `json:"base_pokemon"`
+
+	// Notable events since it was caught, oldest first
+	History []HistoryEvent `json:"history,omitempty"`
 }
 
-func NewPartyPokemon(base pokeapi.Pokemon) *PartyPokemon {
-	return &PartyPokemon{
-		InstanceID:   uuid.New().String(), // Generate a new UUID
-		Nickname:     base.Name,
-		Level:        5,
-		Experience:   0,
-		CaughtAt:     time.Now(),
-		BasePokemon:  base,
-		CurrentStats: calculateInitialStats(base),
-	}
+// HistoryEvent records something that happened to a party member.
+type HistoryEvent struct {
+	At    time.Time `json:"at"`
+	Event string    `json:"event"` // e.g. "evolved"
+	Level int       `json:"level"`
+	From  string    `json:"from,omitempty"`
+	To    string    `json:"to,omitempty"`
+	// Reason says what caused the event, e.g. "level 16" or "use thunder-stone"
+	Reason string `json:"reason,omitempty"`
 }
 
-func calculateInitialStats(base pokeapi.Pokemon) struct {
+// DefaultLevel is the level new party members start at.
+const DefaultLevel = 5
+
+// Stats are a party member's battle stats at its current level.
+type Stats struct {
 	HP             int `json:"hp"`
 	Attack         int `json:"attack"`
 	Defense        int `json:"defense"`
 	SpecialAttack  int `json:"special_attack"`
 	SpecialDefense int `json:"special_defense"`
 	Speed          int `json:"speed"`
-} {
-	var stats struct {
-		HP             int `json:"hp"`
-		Attack         int `json:"attack"`
-		Defense        int `json:"defense"`
-		SpecialAttack  int `json:"special_attack"`
-		SpecialDefense int `json:"special_defense"`
-		Speed          int `json:"speed"`
-	}
+}
+
+// Nature raises one stat by 10% and lowers another by 10%, or neither.
+type Nature struct {
+	Name      string `json:"name"`
+	Increased string `json:"increased,omitempty"` // stat name, e.g. "attack"
+	Decreased string `json:"decreased,omitempty"`
+}
 
-	for _, stat := range base.Stats {
-		switch stat.Stat.Name {
-		case "hp":
-			stats.HP = stat.BaseStat
-		case "attack":
-			stats.Attack = stat.BaseStat
-		case "defense":
-			stats.Defense = stat.BaseStat
-		case "special-attack":
-			stats.SpecialAttack = stat.BaseStat
-		case "special-defense":
-			stats.SpecialDefense = stat.BaseStat
-		case "speed":
-			stats.Speed = stat.BaseStat
-		}
+// NatureFrom converts a PokeAPI nature.
+func NatureFrom(n pokeapi.Nature) *Nature {
+	nature := &Nature{Name: n.Name}
+	if n.IncreasedStat != nil && n.DecreasedStat != nil {
+		nature.Increased = n.IncreasedStat.Name
+		nature.Decreased = n.DecreasedStat.Name
 	}
+	return nature
+}
 
-	return stats
+// NewPartyPokemon creates a freshly caught member with random IVs and no nature.
+func NewPartyPokemon(base pokeapi.Pokemon) *PartyPokemon {
+	p := &PartyPokemon{
+		InstanceID:  uuid.New().String(), // Generate a new UUID
+		Nickname:    base.Name,
+		Level:       DefaultLevel,
+		Experience:  0,
+		CaughtAt:    time.Now(),
+		BasePokemon: base,
+		IVs:         randomIVs(),
+	}
+	p.RecalculateStats()
+	return p
 }
@@ -154,6 +154,12 @@ func (p *Persistence) Save(data *Data) error {
 	return nil
 }
 
+// Dir returns the data directory holding the save file.
+// Other on-disk state, such as the HTTP cache, lives alongside it.
+func (p *Persistence) Dir() string {
+	return filepath.Dir(p.filePath)
+}
+
 // SetLogger sets the logger instance for the Persistence object.
 func (p *Persistence) SetLogger(lgr *logger.Logger) {
 	if lgr != nil {
@@ -1,175 +1,78 @@
 package pokeapi
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
-	"io"
-	"net/http"
+	"fmt"
+	"strconv"
 )
 
-func (c *Client) ListLocations(pageURL *string) (Locations, error) {
-	url := baseURL + "/location-area"
-	if pageURL != nil {
-		c.logger.Debug("Using page URL: %s", *pageURL)
-		url = *pageURL
+func (c *Client) ListLocations(ctx context.Context, pageURL *string) (Locations, error) {
+	if pageURL == nil {
+		return c.ListResources(ctx, "location-area", 0, DefaultPageSize)
 	}
 
-	c.logger.Debug("Fetching locations from: %s", url)
-
-	cacheKey := "location-key-" + url
-
-	if cachedResp, ok := c.cache.Get(cacheKey); ok {
-		c.logger.Debug("Cache hit for locations")
-		locationsResp := Locations{}
-		err := json.Unmarshal(cachedResp, &locationsResp)
-		if err != nil {
-			return Locations{}, err
-		} else {
-			return locationsResp, nil
-		}
-
-	}
-
-	c.logger.Debug("Cache miss for locations, fetching from API")
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return Locations{}, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return Locations{}, err
-	}
-
-	defer resp.Body.Close()
-
-	dat, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return Locations{}, err
-	}
-
-	locationsResp := Locations{}
-	err = json.Unmarshal(dat, &locationsResp)
-	if err != nil {
-		return Locations{}, err
-	}
+	c.logger.Debug("Fetching locations from: %s", *pageURL)
+	return get[Locations](ctx, c, *pageURL)
+}
 
-	err = c.cache.Add(cacheKey, dat)
-	if err != nil {
-		return Locations{}, err
+// ListResources fetches the page of a list endpoint such as "pokemon" or "type"
+// that starts at offset and holds up to limit resources.
+func (c *Client) ListResources(ctx context.Context, endpoint string, offset, limit int) (NamedAPIResourceList, error) {
+	if endpoint == "" {
+		c.logger.Error("Endpoint is required")
+		return NamedAPIResourceList{}, errors.New("endpoint is required")
 	}
 
-	return locationsResp, nil
+	// Same query order as PokeAPI's own next links, so both share cache entries
+	url := fmt.Sprintf("%s/%s?offset=%d&limit=%d", c.baseURL, endpoint, offset, limit)
+	c.logger.Debug("Fetching %s list from: %s", endpoint, url)
+	return get[NamedAPIResourceList](ctx, c, url)
 }
 
-func (c *Client) FetchAreaPokemon(area string) (Area, error) {
-	url := baseURL + "/location-area/" + area
+func (c *Client) FetchAreaPokemon(ctx context.Context, area string) (Area, error) {
 	if area == "" {
 		c.logger.Error("Area name is required")
 		return Area{}, errors.New("area is required")
 	}
 
-	cacheKey := "area-pokemon-key-" + url
 	c.logger.Debug("Fetching Pokemon for area: %s", area)
-
-	if cachedResp, ok := c.cache.Get(cacheKey); ok {
-		c.logger.Debug("Cache hit for area: %s", area)
-		areaPokemon := Area{}
-		err := json.Unmarshal(cachedResp, &areaPokemon)
-		if err != nil {
-			c.logger.Error("Failed to unmarshal cached area data: %v", err)
-			return Area{}, err
-		} else {
-			return areaPokemon, nil
-		}
-	}
-
-	c.logger.Debug("Cache miss for area: %s, fetching from API", area)
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return Area{}, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return Area{}, err
-	}
-
-	defer resp.Body.Close()
-
-	dat, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return Area{}, err
-	}
-
-	areaPokemon := Area{}
-	err = json.Unmarshal(dat, &areaPokemon)
-	if err != nil {
-		return Area{}, err
-	}
-
-	err = c.cache.Add(cacheKey, dat)
-	if err != nil {
-		return Area{}, err
-	}
-
-	return areaPokemon, nil
+	return get[Area](ctx, c, c.baseURL+"/location-area/"+area)
 }
 
-func (c *Client) FetchPokemonSpecies(name string) (PokemonSpecies, error) {
-	url := baseURL + "/pokemon-species/" + name
+func (c *Client) FetchPokemonSpecies(ctx context.Context, name string) (PokemonSpecies, error) {
 	if name == "" {
 		return PokemonSpecies{}, errors.New("name is required")
 	}
 
-	cacheKey := "species-key-" + url
-
-	if cachedResp, ok := c.cache.Get(cacheKey); ok {
-		speciesResp := PokemonSpecies{}
-		err := json.Unmarshal(cachedResp, &speciesResp)
-		if err != nil {
-			return PokemonSpecies{}, err
-		} else {
-			return speciesResp, nil
-		}
-	}
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return PokemonSpecies{}, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return PokemonSpecies{}, err
-	}
-
-	defer resp.Body.Close()
+	return get[PokemonSpecies](ctx, c, c.baseURL+"/pokemon-species/"+name)
+}
 
-	dat, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return PokemonSpecies{}, err
+// FetchEvolutionChain fetches an evolution chain by id, as found in a species'
+// EvolutionChain link.
+func (c *Client) FetchEvolutionChain(ctx context.Context, id int) (EvolutionChain, error) {
+	if id <= 0 {
+		c.logger.Error("Invalid evolution chain id: %d", id)
+		return EvolutionChain{}, fmt.Errorf("invalid evolution chain id %d", id)
 	}
 
-	speciesResp := PokemonSpecies{}
-	err = json.Unmarshal(dat, &speciesResp)
-	if err != nil {
-		return PokemonSpecies{}, err
-	}
+	c.logger.Debug("Fetching evolution chain: %d", id)
+	return get[EvolutionChain](ctx, c, c.baseURL+"/evolution-chain/"+strconv.Itoa(id))
+}
 
-	err = c.cache.Add(cacheKey, dat)
-	if err != nil {
-		return PokemonSpecies{}, err
+// FetchGrowthRate fetches a growth rate and its experience table by name, as
+// found in a species' GrowthRate link.
+func (c *Client) FetchGrowthRate(ctx context.Context, name string) (GrowthRate, error) {
+	if name == "" {
+		c.logger.Error("Growth rate name is required")
+		return GrowthRate{}, errors.New("growth rate name is required")
 	}
 
-	return speciesResp, nil
+	c.logger.Debug("Fetching growth rate: %s", name)
+	return get[GrowthRate](ctx, c, c.baseURL+"/growth-rate/"+name)
 }
 
-func (c *Client) FetchPokemon(pokemonName string) (Pokemon, error) {
-	url := baseURL + "/pokemon/" + pokemonName
-
+func (c *Client) FetchPokemon(ctx context.Context, pokemonName string) (Pokemon, error) {
 	// Log the API request attempt
 	c.logger.Debug("Attempting to fetch Pokemon: %s", pokemonName)
 
@@ -177,49 +80,45 @@ func (c *Client) FetchPokemon(pokemonName string) (Pokemon, error) {
 		return Pokemon{}, errors.New("name is required")
 	}
 
-	cacheKey := "pokemon-key-" + url
-
-	if cachedResp, ok := c.cache.Get(cacheKey); ok {
-		c.logger.Debug("Cache hit for Pokemon: %s", pokemonName)
-		pokemonResp := Pokemon{}
-		err := json.Unmarshal(cachedResp, &pokemonResp)
-		if err != nil {
-			c.logger.Error("Failed to unmarshal cached Pokemon data: %v", err)
-			return Pokemon{}, err
-		} else {
-			return pokemonResp, nil
-		}
+	return get[Pokemon](ctx, c, c.baseURL+"/pokemon/"+pokemonName)
+}
+
+func (c *Client) FetchNature(ctx context.Context, name string) (Nature, error) {
+	if name == "" {
+		c.logger.Error("Nature name is required")
+		return Nature{}, errors.New("nature name is required")
 	}
 
-	c.logger.Debug("Cache miss for Pokemon: %s, fetching from API", pokemonName)
+	c.logger.Debug("Fetching nature: %s", name)
+	return get[Nature](ctx, c, c.baseURL+"/nature/"+name)
+}
 
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		c.logger.Error("Failed to fetch Pokemon from API: %v", err)
-		return Pokemon{}, err
+func (c *Client) FetchType(ctx context.Context, name string) (Type, error) {
+	if name == "" {
+		c.logger.Error("Type name is required")
+		return Type{}, errors.New("type name is required")
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return Pokemon{}, err
-	}
-	defer resp.Body.Close()
+	c.logger.Debug("Fetching type: %s", name)
+	return get[Type](ctx, c, c.baseURL+"/type/"+name)
+}
 
-	dat, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return Pokemon{}, err
+func (c *Client) FetchMove(ctx context.Context, name string) (Move, error) {
+	if name == "" {
+		c.logger.Error("Move name is required")
+		return Move{}, errors.New("move name is required")
 	}
 
-	pokemonResp := Pokemon{}
-	err = json.Unmarshal(dat, &pokemonResp)
-	if err != nil {
-		return Pokemon{}, err
-	}
+	c.logger.Debug("Fetching move: %s", name)
+	return get[Move](ctx, c, c.baseURL+"/move/"+name)
+}
 
-	err = c.cache.Add(cacheKey, dat)
-	if err != nil {
-		return Pokemon{}, err
+func (c *Client) FetchAbility(ctx context.Context, name string) (Ability, error) {
+	if name == "" {
+		c.logger.Error("Ability name is required")
+		return Ability{}, errors.New("ability name is required")
 	}
 
-	return pokemonResp, nil
+	c.logger.Debug("Fetching ability: %s", name)
+	return get[Ability](ctx, c, c.baseURL+"/ability/"+name)
 }
@@ -1,7 +1,9 @@
 package pokeapi
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -12,7 +14,8 @@ import (
 )
 
 // Helper function to create a mock server
-func setupMockServer(t *testing.T, handler http.HandlerFunc) (*httptest.Server, *Client) {
+// Options in opts are applied after the test defaults, so they can override them.
+func setupMockServer(t *testing.T, handler http.HandlerFunc, opts ...Option) (*httptest.Server, *Client) {
 	server := httptest.NewServer(handler)
 
 	// Configure logger for testing (set to NONE to discard output)
@@ -22,16 +25,17 @@ func setupMockServer(t *testing.T, handler http.HandlerFunc) (*httptest.Server,
 
 	// Configure cache for testing
 	testCache := cache.NewCache(5*time.Minute, testLogger) // Correct parameters for NewCache
+	t.Cleanup(func() { testCache.Close() })
 
 	// Create a client pointing to the mock server
-	client := NewClient(5*time.Second, testCache, testLogger)
-	originalBaseURL := baseURL
-	baseURL = server.URL // Override baseURL for testing
-	t.Cleanup(func() {   // Use t.Cleanup to restore baseURL after test
-		baseURL = originalBaseURL
-	})
+	client := NewClient(append([]Option{
+		WithCache(testCache),
+		WithLogger(testLogger),
+		WithRetryPolicy(RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}),
+		WithBaseURL(server.URL),
+	}, opts...)...)
 
-	return server, &client
+	return server, client
 }
 
 func TestListLocations(t *testing.T) {
@@ -47,10 +51,7 @@ func TestListLocations(t *testing.T) {
 				Count:    1,
 				Next:     nil,
 				Previous: nil,
-				Results: []struct {
-					Name string `json:"name"`
-					URL  string `json:"url"`
-				}{
+				Results: []NamedAPIResource{
 					{Name: "test-location", URL: "http://example.com/test"},
 				},
 			}
@@ -60,7 +61,7 @@ func TestListLocations(t *testing.T) {
 		server, client := setupMockServer(t, handler)
 		defer server.Close()
 
-		locations, err := client.ListLocations(nil)
+		locations, err := client.ListLocations(context.Background(), nil)
 		if err != nil {
 			t.Fatalf("Expected no error, got %v", err)
 		}
@@ -80,10 +81,7 @@ func TestListLocations(t *testing.T) {
 			w.WriteHeader(http.StatusOK)
 			expectedResp := Locations{
 				Count: 1,
-				Results: []struct {
-					Name string `json:"name"`
-					URL  string `json:"url"`
-				}{
+				Results: []NamedAPIResource{
 					{Name: "cached-location", URL: "http://example.com/cached"},
 				},
 			}
@@ -93,7 +91,7 @@ func TestListLocations(t *testing.T) {
 		server, client := setupMockServer(t, firstCallHandler)
 		defer server.Close()
 
-		_, err := client.ListLocations(nil) // First call
+		_, err := client.ListLocations(context.Background(), nil) // First call
 		if err != nil {
 			t.Fatalf("First call failed: %v", err)
 		}
@@ -104,7 +102,7 @@ func TestListLocations(t *testing.T) {
 		})
 		server.Config.Handler = secondCallHandler // Update server handler
 
-		locations, err := client.ListLocations(nil) // Second call (should hit cache)
+		locations, err := client.ListLocations(context.Background(), nil) // Second call (should hit cache)
 		if err != nil {
 			t.Fatalf("Expected no error on cache hit, got %v", err)
 		}
@@ -114,15 +112,357 @@ func TestListLocations(t *testing.T) {
 		}
 	})
 
+	t.Run("Server error", func(t *testing.T) {
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		})
+
+		server, client := setupMockServer(t, handler)
+		defer server.Close()
+
+		_, err := client.ListLocations(context.Background(), nil)
+		var httpErr *HTTPError
+		if !errors.As(err, &httpErr) {
+			t.Fatalf("Expected *HTTPError, got %v", err)
+		}
+		if httpErr.Status != http.StatusInternalServerError {
+			t.Errorf("Expected status 500, got %d", httpErr.Status)
+		}
+	})
+
 	// TODO: Add tests for pagination (Next/Previous URLs)
-	// TODO: Add tests for API errors (e.g., 500 status)
 	// TODO: Add tests for unmarshalling errors
 }
 
-// TODO: Add TestFetchAreaPokemon
-// TODO: Add TestFetchPokemonSpecies
-// TODO: Add TestFetchPokemon
+func TestFetchPokemon(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/pokemon/pikachu" {
+			t.Errorf("Expected path /pokemon/pikachu, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Pokemon{ID: 25, Name: "pikachu", BaseExperience: 112})
+	})
+
+	server, client := setupMockServer(t, handler)
+	defer server.Close()
+
+	pokemon, err := client.FetchPokemon(context.Background(), "pikachu")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if pokemon.ID != 25 || pokemon.BaseExperience != 112 {
+		t.Errorf("Unexpected pokemon: %+v", pokemon)
+	}
+
+	if _, err := client.FetchPokemon(context.Background(), ""); err == nil {
+		t.Errorf("Expected error for empty name, got nil")
+	}
+}
+
+func TestFetchPokemonNotFound(t *testing.T) {
+	calls := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, "Not Found", http.StatusNotFound)
+	})
+
+	server, client := setupMockServer(t, handler)
+	defer server.Close()
+
+	for i := 0; i < 2; i++ {
+		_, err := client.FetchPokemon(context.Background(), "notapokemon")
+		if !errors.Is(err, ErrNotFound) {
+			t.Fatalf("Expected ErrNotFound, got %v", err)
+		}
+	}
+
+	// Failed responses must never be cached
+	if calls != 2 {
+		t.Errorf("Expected 2 requests to the server, got %d", calls)
+	}
+}
+
+func TestFetchPokemonSpecies(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/pokemon-species/pikachu" {
+			t.Errorf("Expected path /pokemon-species/pikachu, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(PokemonSpecies{Name: "pikachu", CaptureRate: 190})
+	})
+
+	server, client := setupMockServer(t, handler)
+	defer server.Close()
+
+	species, err := client.FetchPokemonSpecies(context.Background(), "pikachu")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if species.CaptureRate != 190 {
+		t.Errorf("Expected capture rate 190, got %d", species.CaptureRate)
+	}
+}
+
+func TestPokemonSpeciesDefaultPokemon(t *testing.T) {
+	var toxtricity PokemonSpecies
+	err := json.Unmarshal([]byte(`{"name": "toxtricity", "varieties": [
+		{"is_default": true, "pokemon": {"name": "toxtricity-amped"}}, {"is_default": false, "pokemon": {"name": "toxtricity-low-key"}}]}`), &toxtricity)
+	if err != nil {
+		t.Fatalf("Invalid test JSON: %v", err)
+	}
+	if name := toxtricity.DefaultPokemon(); name != "toxtricity-amped" {
+		t.Errorf("Expected toxtricity-amped, got %s", name)
+	}
+
+	// Without varieties the species name is used
+	if name := (PokemonSpecies{Name: "pikachu"}).DefaultPokemon(); name != "pikachu" {
+		t.Errorf("Expected pikachu, got %s", name)
+	}
+}
+
+func TestFetchType(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/type/electric" {
+			t.Errorf("Expected path /type/electric, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"id": 13, "name": "electric", "move_damage_class": null,
+			"damage_relations": {"no_damage_to": [{"name": "ground", "url": ""}], "double_damage_from": [{"name": "ground", "url": ""}]},
+			"pokemon": [{"slot": 1, "pokemon": {"name": "pikachu", "url": ""}}]}`))
+	})
+
+	server, client := setupMockServer(t, handler)
+	defer server.Close()
+
+	electric, err := client.FetchType(context.Background(), "electric")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if electric.ID != 13 || electric.MoveDamageClass != nil || len(electric.Pokemon) != 1 {
+		t.Errorf("Unexpected type: %+v", electric)
+	}
+	if relations := electric.DamageRelations; len(relations.NoDamageTo) != 1 || relations.NoDamageTo[0].Name != "ground" {
+		t.Errorf("Unexpected damage relations: %+v", relations)
+	}
+
+	if _, err := client.FetchType(context.Background(), ""); err == nil {
+		t.Errorf("Expected error for empty name, got nil")
+	}
+}
+
+func TestFetchMove(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/move/thunderbolt":
+			w.Write([]byte(`{"id": 85, "name": "thunderbolt", "power": 90, "pp": 15, "accuracy": 100, "effect_chance": 10,
+				"damage_class": {"name": "special", "url": ""}, "type": {"name": "electric", "url": ""},
+				"effect_entries": [{"short_effect": "Has a $effect_chance% chance to paralyze the target.", "language": {"name": "en", "url": ""}}]}`))
+		case "/move/splash":
+			w.Write([]byte(`{"id": 150, "name": "splash", "power": null, "pp": 40, "accuracy": null, "effect_chance": null, "effect_entries": []}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+
+	server, client := setupMockServer(t, handler)
+	defer server.Close()
+
+	thunderbolt, err := client.FetchMove(context.Background(), "thunderbolt")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if *thunderbolt.Power != 90 || *thunderbolt.PP != 15 || thunderbolt.DamageClass.Name != "special" {
+		t.Errorf("Unexpected move: %+v", thunderbolt)
+	}
+	if effect := thunderbolt.ShortEffect(); effect != "Has a 10% chance to paralyze the target." {
+		t.Errorf("Expected the effect chance to be filled in, got %q", effect)
+	}
+
+	splash, err := client.FetchMove(context.Background(), "splash")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if splash.Power != nil || splash.Accuracy != nil || splash.ShortEffect() != "" {
+		t.Errorf("Expected splash to have no power, accuracy or effect, got %+v", splash)
+	}
+}
+
+func TestFetchAbility(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ability/static" {
+			t.Errorf("Expected path /ability/static, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"id": 9, "name": "static", "is_main_series": true,
+			"effect_entries": [
+				{"short_effect": "Peut paralyser au contact.", "language": {"name": "fr", "url": ""}},
+				{"short_effect": "Has a 30% chance of paralyzing attacking Pokemon on contact.", "language": {"name": "en", "url": ""}}],
+			"pokemon": [{"is_hidden": false, "slot": 1, "pokemon": {"name": "pikachu", "url": ""}}]}`))
+	})
+
+	server, client := setupMockServer(t, handler)
+	defer server.Close()
+
+	static, err := client.FetchAbility(context.Background(), "static")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	effect, ok := EnglishEffect(static.EffectEntries)
+	if !ok || effect.ShortEffect != "Has a 30% chance of paralyzing attacking Pokemon on contact." {
+		t.Errorf("Expected the English effect, got %+v", effect)
+	}
+	if len(static.Pokemon) != 1 || static.Pokemon[0].Pokemon.Name != "pikachu" {
+		t.Errorf("Unexpected ability pokemon: %+v", static.Pokemon)
+	}
+}
+
+func TestFetchEvolutionChain(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/evolution-chain/10" {
+			t.Errorf("Expected path /evolution-chain/10, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"id": 10, "chain": {"is_baby": true, "species": {"name": "pichu"}, "evolution_details": [],
+			"evolves_to": [{"species": {"name": "pikachu"}, "evolution_details": [{"trigger": {"name": "level-up"}, "min_happiness": 220}],
+				"evolves_to": [{"species": {"name": "raichu"}, "evolution_details": [{"trigger": {"name": "use-item"}, "item": {"name": "thunder-stone"}}]}]}]}}`))
+	})
+
+	server, client := setupMockServer(t, handler)
+	defer server.Close()
+
+	chain, err := client.FetchEvolutionChain(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !chain.Chain.IsBaby || chain.Chain.Species.Name != "pichu" {
+		t.Errorf("Expected the chain to start at baby pichu, got %+v", chain.Chain)
+	}
+	raichu, ok := chain.Chain.Find("raichu")
+	if !ok || raichu.EvolutionDetails[0].Item.Name != "thunder-stone" {
+		t.Errorf("Expected raichu to evolve with a thunder-stone, got %+v", raichu)
+	}
+	if _, ok := chain.Chain.Find("venusaur"); ok {
+		t.Errorf("Expected venusaur not to be in the pikachu chain")
+	}
+
+	if _, err := client.FetchEvolutionChain(context.Background(), 0); err == nil {
+		t.Errorf("Expected error for an invalid id, got nil")
+	}
+}
+
+func TestFetchPokemonSpeciesEvolutionChainID(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"name": "raichu", "evolution_chain": {"url": "https://pokeapi.co/api/v2/evolution-chain/10/"},
+			"evolves_from_species": {"name": "pikachu"}, "growth_rate": {"name": "medium"}}`))
+	})
+
+	server, client := setupMockServer(t, handler)
+	defer server.Close()
+
+	species, err := client.FetchPokemonSpecies(context.Background(), "raichu")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if id, err := species.EvolutionChain.ID(); err != nil || id != 10 {
+		t.Errorf("Expected evolution chain 10, got %d, %v", id, err)
+	}
+	if species.EvolvesFromSpecies == nil || species.EvolvesFromSpecies.Name != "pikachu" || species.GrowthRate.Name != "medium" {
+		t.Errorf("Unexpected species: %+v", species)
+	}
+}
+
+func TestFetchGrowthRate(t *testing.T) {
+	server, client := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request for %s", r.URL.Path)
+	}, WithOffline(true))
+	defer server.Close()
+
+	// The bundled snapshot has every growth rate
+	medium, err := client.FetchGrowthRate(context.Background(), "medium")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(medium.Levels) != MaxLevel || medium.ExperienceFor(16) != 4096 || medium.ExperienceFor(MaxLevel) != 1000000 {
+		t.Errorf("Unexpected medium growth rate: %+v", medium.Levels[:3])
+	}
+
+	tests := []struct {
+		experience int
+		want       int
+	}{
+		{0, 1},
+		{124, 4},
+		{125, 5},
+		{4095, 15},
+		{4096, 16},
+		{2000000, MaxLevel},
+	}
+	for _, tt := range tests {
+		if got := medium.LevelFor(tt.experience); got != tt.want {
+			t.Errorf("LevelFor(%d) = %d, want %d", tt.experience, got, tt.want)
+		}
+	}
+
+	if _, err := client.FetchGrowthRate(context.Background(), ""); err == nil {
+		t.Errorf("Expected error for empty name, got nil")
+	}
+}
+
+func TestFetchNature(t *testing.T) {
+	server, client := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request for %s", r.URL.Path)
+	}, WithOffline(true))
+	defer server.Close()
+
+	// The bundled snapshot has every nature
+	adamant, err := client.FetchNature(context.Background(), "adamant")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if adamant.IncreasedStat == nil || adamant.IncreasedStat.Name != "attack" || adamant.DecreasedStat.Name != "special-attack" {
+		t.Errorf("Unexpected adamant nature: %+v", adamant)
+	}
+
+	hardy, err := client.FetchNature(context.Background(), "hardy")
+	if err != nil || hardy.IncreasedStat != nil || hardy.DecreasedStat != nil {
+		t.Errorf("Expected hardy to be neutral, got %+v, %v", hardy, err)
+	}
+
+	list, err := client.ListResources(context.Background(), "nature", 0, 200)
+	if err != nil || list.Count != 25 || len(list.Results) != 25 {
+		t.Errorf("Expected all 25 natures in one page, got %+v, %v", list, err)
+	}
+}
 
 // TODO: Add TestFetchAreaPokemon
-// TODO: Add TestFetchPokemonSpecies
-// TODO: Add TestFetchPokemon
+
+// recordingStore is a cache.Store that remembers every key written to it.
+type recordingStore struct {
+	cache.Nop
+	added []string
+}
+
+func (s *recordingStore) Add(key string, val []byte) error {
+	s.added = append(s.added, key)
+	return nil
+}
+
+func TestClientUsesInjectedStore(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/pokemon/missingno" {
+			http.Error(w, "Not Found", http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(Pokemon{ID: 25, Name: "pikachu"})
+	})
+
+	server, client := setupMockServer(t, handler)
+	defer server.Close()
+
+	store := &recordingStore{}
+	client.cache = store
+
+	client.FetchPokemon(context.Background(), "pikachu")
+	client.FetchPokemon(context.Background(), "missingno")
+
+	if len(store.added) != 1 || store.added[0] != server.URL+"/pokemon/pikachu" {
+		t.Errorf("Expected only the successful response to be stored, got %v", store.added)
+	}
+}
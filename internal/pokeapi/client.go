@@ -1,6 +1,8 @@
 package pokeapi
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"time"
 
@@ -8,20 +10,102 @@ import (
 	"github.com/sakuffo/pokedexcli/internal/logger"
 )
 
+const (
+	// DefaultTimeout bounds a single request, including reading the body.
+	DefaultTimeout = 5 * time.Second
+	// DefaultUserAgent identifies the CLI to PokeAPI.
+	DefaultUserAgent = "pokedexcli"
+)
+
 // Client
 type Client struct {
 	httpClient http.Client
-	cache      *cache.Cache // Keep as pointer
+	baseURL    string
+	userAgent  string
+	cache      cache.Store
 	logger     *logger.Logger
+	retry      RetryPolicy
+	limiter    *rateLimiter
+	flights    *flightGroup
+	offline    bool
 }
 
-func NewClient(timeout time.Duration, cache *cache.Cache, logger *logger.Logger) Client {
-	logger.Debug("Creating new PokeAPI client")
-	return Client{
+// NewClient returns a client for the public PokeAPI with the default timeout,
+// retry policy and rate limit and no cache. Options override any of these.
+func NewClient(opts ...Option) *Client {
+	c := &Client{
 		httpClient: http.Client{
-			Timeout: timeout,
+			Timeout: DefaultTimeout,
 		},
-		cache:  cache, // Don't dereference
-		logger: logger,
+		baseURL:   DefaultBaseURL,
+		userAgent: DefaultUserAgent,
+		cache:     cache.Nop{},
+		logger:    logger.New(logger.NONE),
+		retry:     DefaultRetryPolicy,
+		limiter:   newRateLimiter(DefaultRateLimit, DefaultRateBurst),
+		flights:   newFlightGroup(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.logger.Debug("Created PokeAPI client for %s", c.baseURL)
+	return c
+}
+
+// get fetches the resource at url and decodes it into a T.
+// Every endpoint goes through get so caching and error handling stay identical.
+func get[T any](ctx context.Context, c *Client, url string) (T, error) {
+	var resource T
+
+	dat, err := c.fetch(ctx, url)
+	if err != nil {
+		return resource, err
+	}
+
+	if err := json.Unmarshal(dat, &resource); err != nil {
+		c.logger.Error("Failed to unmarshal response from %s: %v", url, err)
+		return resource, err
+	}
+
+	return resource, nil
+}
+
+// fetch returns the raw response body for url, serving it from the cache when possible.
+// Concurrent misses for the same url share a single request.
+// Only successful (2xx) responses are cached; anything else is returned as an *HTTPError.
+func (c *Client) fetch(ctx context.Context, url string) ([]byte, error) {
+	if cachedResp, ok := c.cache.Get(url); ok {
+		c.logger.Debug("Cache hit for %s", url)
+		return cachedResp, nil
 	}
+
+	if c.offline {
+		if dat, ok := c.fromSnapshot(url); ok {
+			return dat, nil
+		}
+		c.logger.Info("%s is not cached or bundled, unavailable offline", url)
+		return nil, &OfflineError{URL: url}
+	}
+
+	c.logger.Debug("Cache miss for %s, fetching from API", url)
+
+	dat, shared, err := c.flights.do(ctx, url, func() ([]byte, error) {
+		dat, err := c.fetchWithRetry(ctx, url)
+		if err != nil {
+			return nil, err
+		}
+
+		err = c.cache.Add(url, dat)
+		if err != nil {
+			return nil, err
+		}
+
+		return dat, nil
+	})
+	if shared {
+		c.logger.Debug("Shared in-flight request for %s", url)
+	}
+
+	return dat, err
 }
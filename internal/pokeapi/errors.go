@@ -0,0 +1,66 @@
+package pokeapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+var (
+	// ErrNotFound is returned when PokeAPI has no resource at the requested URL.
+	ErrNotFound = errors.New("resource not found")
+	// ErrRateLimited is returned when PokeAPI rejects a request with 429 Too Many Requests.
+	ErrRateLimited = errors.New("rate limited by PokeAPI")
+	// ErrOffline is returned in offline mode for resources that are neither cached nor bundled.
+	ErrOffline = errors.New("not available offline")
+)
+
+// HTTPError describes a non-2xx response from PokeAPI.
+// It matches ErrNotFound and ErrRateLimited with errors.Is for the relevant statuses.
+type HTTPError struct {
+	Status int
+	URL    string
+	// RetryAfter is the delay requested by the server's Retry-After header, if any.
+	RetryAfter time.Duration
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("unexpected status %d %s from %s", e.Status, http.StatusText(e.Status), e.URL)
+}
+
+func (e *HTTPError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Status == http.StatusNotFound
+	case ErrRateLimited:
+		return e.Status == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// OfflineError reports a resource that could not be served in offline mode.
+// It matches ErrOffline with errors.Is.
+type OfflineError struct {
+	URL string
+}
+
+func (e *OfflineError) Error() string {
+	return fmt.Sprintf("%s is not available offline", e.URL)
+}
+
+func (e *OfflineError) Is(target error) bool {
+	return target == ErrOffline
+}
+
+// checkStatus returns an *HTTPError for any response outside the 2xx range.
+func checkStatus(resp *http.Response, url string) error {
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &HTTPError{
+			Status:     resp.StatusCode,
+			URL:        url,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	}
+	return nil
+}
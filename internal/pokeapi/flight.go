@@ -0,0 +1,63 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// flightGroup deduplicates concurrent fetches of the same key so only one
+// request is in flight per URL and every caller shares its result.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+type flightCall struct {
+	done chan struct{}
+	val  []byte
+	err  error
+}
+
+func newFlightGroup() *flightGroup {
+	return &flightGroup{calls: make(map[string]*flightCall)}
+}
+
+// do runs fn for key unless a call for key is already running, in which case it
+// waits for that call's result. shared reports whether the result came from another caller.
+func (g *flightGroup) do(ctx context.Context, key string, fn func() ([]byte, error)) (val []byte, shared bool, err error) {
+	for {
+		g.mu.Lock()
+		call, inFlight := g.calls[key]
+		if !inFlight {
+			call = &flightCall{done: make(chan struct{})}
+			g.calls[key] = call
+			g.mu.Unlock()
+
+			call.val, call.err = fn()
+
+			g.mu.Lock()
+			delete(g.calls, key)
+			g.mu.Unlock()
+			close(call.done)
+			return call.val, false, call.err
+		}
+		g.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, true, ctx.Err()
+		case <-call.done:
+		}
+
+		// The leading caller may have been cancelled while we still want the result
+		if isContextError(call.err) && ctx.Err() == nil {
+			continue
+		}
+		return call.val, true, call.err
+	}
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
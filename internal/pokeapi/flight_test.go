@@ -0,0 +1,72 @@
+package pokeapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestConcurrentFetchesAreCoalesced(t *testing.T) {
+	var calls atomic.Int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		time.Sleep(50 * time.Millisecond) // keep the request in flight while others arrive
+		json.NewEncoder(w).Encode(Pokemon{ID: 25, Name: "pikachu"})
+	})
+
+	server, client := setupMockServer(t, handler)
+	defer server.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pokemon, err := client.FetchPokemon(context.Background(), "pikachu")
+			if err != nil || pokemon.Name != "pikachu" {
+				t.Errorf("Unexpected result: %+v, %v", pokemon, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := calls.Load(); n != 1 {
+		t.Errorf("Expected 1 request to the server, got %d", n)
+	}
+}
+
+func TestFlightSurvivesCancelledLeader(t *testing.T) {
+	group := newFlightGroup()
+	leaderStarted := make(chan struct{})
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+
+	go group.do(leaderCtx, "key", func() ([]byte, error) {
+		close(leaderStarted)
+		<-leaderCtx.Done()
+		return nil, leaderCtx.Err()
+	})
+	<-leaderStarted
+
+	result := make(chan error, 1)
+	go func() {
+		val, _, err := group.do(context.Background(), "key", func() ([]byte, error) {
+			return []byte("fresh"), nil
+		})
+		if err == nil && string(val) != "fresh" {
+			err = errors.New("unexpected value " + string(val))
+		}
+		result <- err
+	}()
+
+	time.Sleep(10 * time.Millisecond) // let the waiter join the leader's call
+	cancelLeader()
+
+	if err := <-result; err != nil {
+		t.Errorf("Expected waiter to retry after the leader was cancelled, got %v", err)
+	}
+}
@@ -1,17 +1,43 @@
 package pokeapi
 
-// PokemonClient defines the interface for Pokemon data retrieval
+import "context"
+
+// PokemonClient defines the interface for Pokemon data retrieval.
+// Commands only depend on this, so the HTTP client, the imported dataset and test fakes are interchangeable.
 type PokemonClient interface {
 
 	// ListLocations fetches a paginated list of locations
-	ListLocations(pageURL *string) (Locations, error)
+	ListLocations(ctx context.Context, pageURL *string) (Locations, error)
+
+	// ListResources fetches one page of any named-resource list endpoint
+	ListResources(ctx context.Context, endpoint string, offset, limit int) (NamedAPIResourceList, error)
 
 	// FetchAreaPokemon fetches the Pokemon in a specific area
-	FetchAreaPokemon(area *string) (Area, error)
+	FetchAreaPokemon(ctx context.Context, area string) (Area, error)
 
 	// FetchPokemon fetches details about a specific Pokemon
-	FetchPokemon(pokemonName string) (Pokemon, error)
+	FetchPokemon(ctx context.Context, pokemonName string) (Pokemon, error)
 
 	// FetchPokemonSpecies species data for a specific Pokemon
-	FetchPokemonSpecies(pokemonSpeciesName string) (PokemonSpecies, error)
+	FetchPokemonSpecies(ctx context.Context, pokemonSpeciesName string) (PokemonSpecies, error)
+
+	// FetchEvolutionChain fetches the evolution chain with the given id
+	FetchEvolutionChain(ctx context.Context, id int) (EvolutionChain, error)
+
+	// FetchGrowthRate fetches a growth rate's experience table
+	FetchGrowthRate(ctx context.Context, name string) (GrowthRate, error)
+
+	// FetchNature fetches a nature and the stats it raises and lowers
+	FetchNature(ctx context.Context, name string) (Nature, error)
+
+	// FetchType fetches a type and its damage relations
+	FetchType(ctx context.Context, name string) (Type, error)
+
+	// FetchMove fetches a move's power, accuracy, PP and effect
+	FetchMove(ctx context.Context, name string) (Move, error)
+
+	// FetchAbility fetches an ability and its effect
+	FetchAbility(ctx context.Context, name string) (Ability, error)
 }
+
+var _ PokemonClient = (*Client)(nil)
@@ -0,0 +1,27 @@
+package pokeapi
+
+import (
+	"io/fs"
+	"net/url"
+	"strings"
+)
+
+// fromSnapshot looks up rawURL in the bundled snapshot.
+func (c *Client) fromSnapshot(rawURL string) ([]byte, bool) {
+	if !strings.HasPrefix(rawURL, c.baseURL) {
+		return nil, false
+	}
+
+	parsed, err := url.Parse(strings.TrimPrefix(rawURL, c.baseURL))
+	if err != nil {
+		return nil, false
+	}
+
+	dat, err := fs.ReadFile(Snapshot(), SnapshotPath(parsed.Path, parsed.RawQuery))
+	if err != nil {
+		return nil, false
+	}
+
+	c.logger.Debug("Serving %s from bundled snapshot", rawURL)
+	return dat, true
+}
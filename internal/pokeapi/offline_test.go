@@ -0,0 +1,80 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestOfflineServesSnapshot(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Server should not be called in offline mode, got %s", r.URL.Path)
+	})
+
+	server, client := setupMockServer(t, handler, WithOffline(true))
+	defer server.Close()
+
+	pokemon, err := client.FetchPokemon(context.Background(), "pikachu")
+	if err != nil {
+		t.Fatalf("Expected bundled pikachu, got %v", err)
+	}
+	if pokemon.ID != 25 || pokemon.Name != "pikachu" {
+		t.Errorf("Unexpected pokemon: %+v", pokemon)
+	}
+
+	locations, err := client.ListLocations(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Expected bundled first page of locations, got %v", err)
+	}
+	if len(locations.Results) != 20 {
+		t.Errorf("Expected 20 bundled locations, got %d", len(locations.Results))
+	}
+
+	_, err = client.FetchPokemon(context.Background(), "mew")
+	var offlineErr *OfflineError
+	if !errors.Is(err, ErrOffline) || !errors.As(err, &offlineErr) {
+		t.Fatalf("Expected *OfflineError, got %v", err)
+	}
+}
+
+func TestOfflineServesCache(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": 151, "name": "mew"}`))
+	})
+
+	server, client := setupMockServer(t, handler)
+	defer server.Close()
+
+	if _, err := client.FetchPokemon(context.Background(), "mew"); err != nil {
+		t.Fatalf("Online fetch failed: %v", err)
+	}
+
+	client.offline = true
+	pokemon, err := client.FetchPokemon(context.Background(), "mew")
+	if err != nil {
+		t.Fatalf("Expected cached mew offline, got %v", err)
+	}
+	if pokemon.ID != 151 {
+		t.Errorf("Unexpected pokemon: %+v", pokemon)
+	}
+}
+
+func TestSnapshotPath(t *testing.T) {
+	cases := []struct {
+		path     string
+		query    string
+		expected string
+	}{
+		{path: "/pokemon/pikachu", expected: "pokemon/pikachu.json"},
+		{path: "/pokemon/pikachu/", expected: "pokemon/pikachu.json"},
+		{path: "/location-area", query: "offset=20&limit=20", expected: "location-area@offset=20&limit=20.json"},
+		{path: "/../../etc/passwd", expected: "etc/passwd.json"},
+	}
+
+	for _, c := range cases {
+		if actual := SnapshotPath(c.path, c.query); actual != c.expected {
+			t.Errorf("SnapshotPath(%q, %q) == %q, expected %q", c.path, c.query, actual, c.expected)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package pokeapi
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sakuffo/pokedexcli/internal/cache"
+	"github.com/sakuffo/pokedexcli/internal/logger"
+)
+
+// Option configures a Client in NewClient.
+type Option func(*Client)
+
+// WithBaseURL points the client at another PokeAPI-compatible server, such as a
+// mirror or cmd/fakepokeapi. The URL is the API root, e.g. http://localhost:8000/api/v2.
+func WithBaseURL(url string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimSuffix(url, "/")
+	}
+}
+
+// WithTimeout bounds each request, including reading the body.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.httpClient.Timeout = timeout
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithTransport replaces the transport requests are sent over, e.g. with a Recorder in tests.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) {
+		c.httpClient.Transport = rt
+	}
+}
+
+// WithCache stores successful responses in store.
+func WithCache(store cache.Store) Option {
+	return func(c *Client) {
+		c.cache = store
+	}
+}
+
+// WithLogger sets the logger the client reports requests and failures to.
+func WithLogger(lgr *logger.Logger) Option {
+	return func(c *Client) {
+		c.logger = lgr
+	}
+}
+
+// WithRateLimit limits the client to requestsPerSecond with bursts of up to burst requests.
+// A non-positive requestsPerSecond disables rate limiting.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(c *Client) {
+		if requestsPerSecond <= 0 {
+			c.limiter = nil
+			return
+		}
+		c.limiter = newRateLimiter(requestsPerSecond, burst)
+	}
+}
+
+// WithRetryPolicy replaces the retry policy used for requests made by the client.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// WithOffline makes the client serve only from its cache and the bundled
+// snapshot. Anything missing from both fails with an *OfflineError.
+func WithOffline(offline bool) Option {
+	return func(c *Client) {
+		c.offline = offline
+	}
+}
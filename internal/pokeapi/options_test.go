@@ -0,0 +1,48 @@
+package pokeapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewClientDefaults(t *testing.T) {
+	client := NewClient()
+
+	if client.baseURL != DefaultBaseURL || client.userAgent != DefaultUserAgent || client.httpClient.Timeout != DefaultTimeout {
+		t.Errorf("Unexpected defaults: base %q, user agent %q, timeout %v", client.baseURL, client.userAgent, client.httpClient.Timeout)
+	}
+	if client.limiter == nil || client.cache == nil {
+		t.Errorf("Expected a rate limiter and a no-op cache by default")
+	}
+}
+
+func TestClientOptions(t *testing.T) {
+	var userAgent string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		w.Write([]byte(`{"name": "pikachu"}`))
+	})
+
+	server, client := setupMockServer(t, handler,
+		WithUserAgent("pokedexcli-test"),
+		WithTimeout(time.Second),
+		WithRateLimit(0, 0),
+	)
+	defer server.Close()
+
+	if client.limiter != nil {
+		t.Errorf("Expected WithRateLimit(0, 0) to disable rate limiting")
+	}
+	if client.httpClient.Timeout != time.Second {
+		t.Errorf("Expected a 1s timeout, got %v", client.httpClient.Timeout)
+	}
+
+	if _, err := client.FetchPokemon(context.Background(), "pikachu"); err != nil {
+		t.Fatalf("FetchPokemon failed: %v", err)
+	}
+	if userAgent != "pokedexcli-test" {
+		t.Errorf("Expected User-Agent pokedexcli-test, got %q", userAgent)
+	}
+}
@@ -0,0 +1,244 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// DefaultPageSize is how many resources PokeAPI returns per page unless asked otherwise.
+const DefaultPageSize = 20
+
+var (
+	ErrNoNextPage     = errors.New("no next page")
+	ErrNoPreviousPage = errors.New("no previous page")
+)
+
+// Pages iterates over the pages of a list endpoint, starting with the page at offset.
+// Each page is fetched only when the loop asks for it, following next links until the
+// last page. A failed fetch is yielded once and ends the iteration.
+func Pages(ctx context.Context, client PokemonClient, endpoint string, offset, limit int) iter.Seq2[NamedAPIResourceList, error] {
+	return func(yield func(NamedAPIResourceList, error) bool) {
+		for {
+			page, err := client.ListResources(ctx, endpoint, offset, limit)
+			if err != nil {
+				yield(page, err)
+				return
+			}
+			if !yield(page, nil) || page.Next == nil {
+				return
+			}
+
+			// Follow the link rather than adding limit, in case the server capped the page size
+			offset, limit, err = pageParams(*page.Next)
+			if err != nil {
+				yield(NamedAPIResourceList{}, err)
+				return
+			}
+		}
+	}
+}
+
+// Resources iterates over every resource of a list endpoint, fetching limit at a time.
+func Resources(ctx context.Context, client PokemonClient, endpoint string, limit int) iter.Seq2[NamedAPIResource, error] {
+	return func(yield func(NamedAPIResource, error) bool) {
+		for page, err := range Pages(ctx, client, endpoint, 0, limit) {
+			if err != nil {
+				yield(NamedAPIResource{}, err)
+				return
+			}
+			for _, res := range page.Results {
+				if !yield(res, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Paginator steps back and forth through a list endpoint, remembering its
+// position between calls so REPL commands like map and mapb can share it.
+type Paginator struct {
+	client   PokemonClient
+	endpoint string
+	limit    int
+
+	started bool
+	offset  int // offset of the last page returned
+	count   int // total resources, as of the last page returned
+	shown   int // resources on the last page returned
+	hasNext bool
+	hasPrev bool
+}
+
+// PageInfo describes where the last page returned sits in the list.
+// Page numbers and resource positions are 1-based.
+type PageInfo struct {
+	Page  int
+	Pages int
+	First int
+	Last  int
+	Count int
+}
+
+// NewPaginator returns a Paginator over endpoint that fetches limit resources per page.
+func NewPaginator(client PokemonClient, endpoint string, limit int) *Paginator {
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	return &Paginator{
+		client:   client,
+		endpoint: endpoint,
+		limit:    limit,
+	}
+}
+
+// Next returns the page after the last one returned, or the first page on the first call.
+func (p *Paginator) Next(ctx context.Context) (NamedAPIResourceList, error) {
+	if !p.started {
+		return p.fetch(ctx, 0)
+	}
+	if !p.hasNext {
+		return NamedAPIResourceList{}, ErrNoNextPage
+	}
+	return p.fetch(ctx, p.offset+p.limit)
+}
+
+// Prev returns the page before the last one returned.
+func (p *Paginator) Prev(ctx context.Context) (NamedAPIResourceList, error) {
+	if !p.started || !p.hasPrev {
+		return NamedAPIResourceList{}, ErrNoPreviousPage
+	}
+	return p.fetch(ctx, max(p.offset-p.limit, 0))
+}
+
+// Page jumps to page n, counting from 1.
+func (p *Paginator) Page(ctx context.Context, n int) (NamedAPIResourceList, error) {
+	if n < 1 || (p.started && n > p.pages()) {
+		return NamedAPIResourceList{}, p.outOfRange(n)
+	}
+
+	page, err := p.load(ctx, (n-1)*p.limit)
+	if err != nil {
+		return page, err
+	}
+	// Until a page has been fetched the count is unknown, so check the answer instead
+	if len(page.Results) == 0 && n > 1 {
+		p.count = page.Count
+		return NamedAPIResourceList{}, p.outOfRange(n)
+	}
+
+	p.move((n-1)*p.limit, page)
+	return page, nil
+}
+
+// First returns the first page.
+func (p *Paginator) First(ctx context.Context) (NamedAPIResourceList, error) {
+	return p.fetch(ctx, 0)
+}
+
+// Last returns the last page, fetching the first one beforehand if the size of
+// the list isn't known yet.
+func (p *Paginator) Last(ctx context.Context) (NamedAPIResourceList, error) {
+	if !p.started {
+		if _, err := p.fetch(ctx, 0); err != nil {
+			return NamedAPIResourceList{}, err
+		}
+	}
+	return p.fetch(ctx, (p.pages()-1)*p.limit)
+}
+
+// SetLimit changes the page size. The paginator moves to the page of the new
+// size that holds the first resource of the last page returned, without fetching it.
+func (p *Paginator) SetLimit(limit int) {
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	p.limit = limit
+	if !p.started {
+		return
+	}
+
+	p.offset = p.offset / limit * limit
+	p.shown = max(min(limit, p.count-p.offset), 0)
+	p.hasNext = p.offset+limit < p.count
+	p.hasPrev = p.offset > 0
+}
+
+// Limit returns the page size.
+func (p *Paginator) Limit() int {
+	return p.limit
+}
+
+// Info describes the last page returned. It is the zero PageInfo until a page has been fetched.
+func (p *Paginator) Info() PageInfo {
+	if !p.started {
+		return PageInfo{}
+	}
+	return PageInfo{
+		Page:  p.offset/p.limit + 1,
+		Pages: p.pages(),
+		First: min(p.offset+1, p.offset+p.shown),
+		Last:  p.offset + p.shown,
+		Count: p.count,
+	}
+}
+
+// pages returns the number of pages in the list, at least 1 so an empty list has a first page.
+func (p *Paginator) pages() int {
+	return max((p.count+p.limit-1)/p.limit, 1)
+}
+
+func (p *Paginator) outOfRange(n int) error {
+	if !p.started && p.count == 0 {
+		return fmt.Errorf("page %d is out of range", n)
+	}
+	return fmt.Errorf("page %d is out of range (1-%d)", n, p.pages())
+}
+
+// fetch returns the page at offset and moves the paginator there if it succeeds.
+func (p *Paginator) fetch(ctx context.Context, offset int) (NamedAPIResourceList, error) {
+	page, err := p.load(ctx, offset)
+	if err != nil {
+		return page, err
+	}
+	p.move(offset, page)
+	return page, nil
+}
+
+// load fetches the page at offset without moving the paginator.
+func (p *Paginator) load(ctx context.Context, offset int) (NamedAPIResourceList, error) {
+	for page, err := range Pages(ctx, p.client, p.endpoint, offset, p.limit) {
+		return page, err
+	}
+	// Pages always yields at least once
+	return NamedAPIResourceList{}, fmt.Errorf("no page at offset %d", offset)
+}
+
+func (p *Paginator) move(offset int, page NamedAPIResourceList) {
+	p.started = true
+	p.offset = offset
+	p.count = page.Count
+	p.shown = len(page.Results)
+	p.hasNext = page.Next != nil
+	p.hasPrev = page.Previous != nil
+}
+
+// pageParams reads offset and limit from a list URL such as a page's next link.
+func pageParams(rawURL string) (offset, limit int, err error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	query := parsed.Query()
+	offset, _ = strconv.Atoi(query.Get("offset"))
+	limit, err = strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = DefaultPageSize
+	}
+	return max(offset, 0), limit, nil
+}
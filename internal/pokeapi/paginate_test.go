@@ -0,0 +1,160 @@
+package pokeapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// listHandler serves a list endpoint of n resources named item-1 to item-n,
+// counting the pages it is asked for.
+func listHandler(n int, requests *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		page := NamedAPIResourceList{Count: n}
+		end := min(offset+limit, n)
+		for i := offset; i < end; i++ {
+			page.Results = append(page.Results, NamedAPIResource{Name: fmt.Sprintf("item-%d", i+1)})
+		}
+		if end < n {
+			next := fmt.Sprintf("http://%s%s?offset=%d&limit=%d", r.Host, r.URL.Path, end, limit)
+			page.Next = &next
+		}
+		if offset > 0 {
+			prev := fmt.Sprintf("http://%s%s?offset=%d&limit=%d", r.Host, r.URL.Path, max(offset-limit, 0), limit)
+			page.Previous = &prev
+		}
+		json.NewEncoder(w).Encode(page)
+	}
+}
+
+func TestPagesFetchesLazily(t *testing.T) {
+	requests := 0
+	server, client := setupMockServer(t, listHandler(25, &requests))
+	defer server.Close()
+
+	for page, err := range Pages(context.Background(), client, "pokemon", 0, 10) {
+		if err != nil {
+			t.Fatalf("Pages failed: %v", err)
+		}
+		if page.Results[0].Name != "item-1" {
+			t.Errorf("Expected the first page, got %+v", page.Results)
+		}
+		break
+	}
+	if requests != 1 {
+		t.Errorf("Expected only the first page to be fetched, got %d requests", requests)
+	}
+}
+
+func TestResourcesFollowsNextLinks(t *testing.T) {
+	requests := 0
+	server, client := setupMockServer(t, listHandler(25, &requests))
+	defer server.Close()
+
+	var names []string
+	for res, err := range Resources(context.Background(), client, "pokemon", 10) {
+		if err != nil {
+			t.Fatalf("Resources failed: %v", err)
+		}
+		names = append(names, res.Name)
+	}
+
+	if len(names) != 25 || names[24] != "item-25" {
+		t.Errorf("Expected all 25 resources in order, got %v", names)
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 pages to be fetched, got %d", requests)
+	}
+}
+
+func TestResourcesStopsOnError(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Not Found", http.StatusNotFound)
+	})
+	server, client := setupMockServer(t, handler)
+	defer server.Close()
+
+	errs := 0
+	for _, err := range Resources(context.Background(), client, "nothing", 10) {
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+		errs++
+	}
+	if errs != 1 {
+		t.Errorf("Expected a single error, got %d", errs)
+	}
+}
+
+func TestPaginator(t *testing.T) {
+	requests := 0
+	server, client := setupMockServer(t, listHandler(25, &requests))
+	defer server.Close()
+	ctx := context.Background()
+
+	p := NewPaginator(client, "location-area", 10)
+	if _, err := p.Prev(ctx); !errors.Is(err, ErrNoPreviousPage) {
+		t.Errorf("Expected ErrNoPreviousPage before the first page, got %v", err)
+	}
+
+	for _, want := range []string{"item-1", "item-11", "item-21"} {
+		page, err := p.Next(ctx)
+		if err != nil {
+			t.Fatalf("Next failed: %v", err)
+		}
+		if page.Results[0].Name != want {
+			t.Errorf("Expected page starting at %s, got %s", want, page.Results[0].Name)
+		}
+	}
+	if _, err := p.Next(ctx); !errors.Is(err, ErrNoNextPage) {
+		t.Errorf("Expected ErrNoNextPage after the last page, got %v", err)
+	}
+
+	page, err := p.Prev(ctx)
+	if err != nil || page.Results[0].Name != "item-11" {
+		t.Errorf("Expected Prev to return the second page, got %+v, %v", page.Results, err)
+	}
+}
+
+func TestPaginatorJumps(t *testing.T) {
+	requests := 0
+	server, client := setupMockServer(t, listHandler(25, &requests))
+	defer server.Close()
+	ctx := context.Background()
+
+	p := NewPaginator(client, "location-area", 10)
+	if _, err := p.Page(ctx, 4); err == nil {
+		t.Errorf("Expected page 4 of 3 to fail before anything was fetched")
+	}
+	if info := p.Info(); info != (PageInfo{}) {
+		t.Errorf("Expected a failed jump not to move the paginator, got %+v", info)
+	}
+
+	if _, err := p.Last(ctx); err != nil {
+		t.Fatalf("Last failed: %v", err)
+	}
+	if info := p.Info(); info != (PageInfo{Page: 3, Pages: 3, First: 21, Last: 25, Count: 25}) {
+		t.Errorf("Unexpected info on the last page: %+v", info)
+	}
+
+	p.SetLimit(4)
+	if info := p.Info(); info != (PageInfo{Page: 6, Pages: 7, First: 21, Last: 24, Count: 25}) {
+		t.Errorf("Unexpected info after resizing: %+v", info)
+	}
+	page, err := p.Next(ctx)
+	if err != nil || page.Results[0].Name != "item-25" {
+		t.Errorf("Expected the next page to start at item-25, got %+v, %v", page.Results, err)
+	}
+
+	if _, err := p.Page(ctx, 8); err == nil || err.Error() != "page 8 is out of range (1-7)" {
+		t.Errorf("Expected an out of range error, got %v", err)
+	}
+}
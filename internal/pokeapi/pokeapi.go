@@ -1,5 +1,5 @@
 package pokeapi
 
-var (
-	baseURL = "https://pokeapi.co/api/v2"
-)
+// DefaultBaseURL is the public PokeAPI. Use WithBaseURL to point a client elsewhere,
+// e.g. at cmd/fakepokeapi during development.
+const DefaultBaseURL = "https://pokeapi.co/api/v2"
@@ -0,0 +1,57 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterBurst(t *testing.T) {
+	limiter := newRateLimiter(1, 3)
+
+	for i := 0; i < 3; i++ {
+		if delay := limiter.reserve(); delay != 0 {
+			t.Errorf("Request %d within burst was delayed by %v", i, delay)
+		}
+	}
+
+	if delay := limiter.reserve(); delay <= 0 {
+		t.Errorf("Expected request beyond burst to be delayed")
+	}
+}
+
+func TestRateLimiterSharedAcrossGoroutines(t *testing.T) {
+	limiter := newRateLimiter(100, 1)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := limiter.wait(context.Background()); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// One request goes immediately, the other four are spaced 10ms apart
+	if elapsed := time.Since(start); elapsed < 35*time.Millisecond {
+		t.Errorf("Expected requests to be spread out, finished in %v", elapsed)
+	}
+}
+
+func TestRateLimiterCancel(t *testing.T) {
+	limiter := newRateLimiter(0.001, 1)
+	limiter.reserve() // drain the bucket
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := limiter.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
@@ -0,0 +1,142 @@
+package pokeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// Recorder is an http.RoundTripper that saves responses as fixture files and
+// replays them later, so tests run against real PokeAPI data without the network.
+// Fixtures are named like snapshot files, keyed by request path and query only,
+// which lets responses recorded from pokeapi.co replay against any base URL.
+type Recorder struct {
+	dir    string
+	record bool
+	next   http.RoundTripper
+}
+
+// fixture is the on-disk form of a recorded response.
+// JSON bodies are stored as-is to keep fixtures readable; anything else goes in Text.
+type fixture struct {
+	Method string            `json:"method"`
+	URL    string            `json:"url"`
+	Status int               `json:"status"`
+	Header map[string]string `json:"header,omitempty"`
+	Body   json.RawMessage   `json:"body,omitempty"`
+	Text   string            `json:"text,omitempty"`
+}
+
+// recordedHeaders are the response headers the client looks at.
+var recordedHeaders = []string{"Content-Type", "Retry-After"}
+
+// NewRecorder returns a Recorder reading fixtures from dir. With record set,
+// requests go out over the default transport and every response is written to dir.
+func NewRecorder(dir string, record bool) *Recorder {
+	return &Recorder{
+		dir:    dir,
+		record: record,
+		next:   http.DefaultTransport,
+	}
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := filepath.Join(r.dir, filepath.FromSlash(SnapshotPath(req.URL.Path, req.URL.RawQuery)))
+	if r.record {
+		return r.recordResponse(req, path)
+	}
+	return r.replayResponse(req, path)
+}
+
+func (r *Recorder) recordResponse(req *http.Request, path string) (*http.Response, error) {
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	fx := fixture{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Status: resp.StatusCode,
+		Header: make(map[string]string),
+	}
+	for _, name := range recordedHeaders {
+		if value := resp.Header.Get(name); value != "" {
+			fx.Header[name] = value
+		}
+	}
+	if json.Valid(body) {
+		fx.Body = body
+	} else {
+		fx.Text = string(body)
+	}
+
+	if err := writeFixture(path, fx); err != nil {
+		return nil, fmt.Errorf("failed to record %s: %w", req.URL, err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+func (r *Recorder) replayResponse(req *http.Request, path string) (*http.Response, error) {
+	dat, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("no recorded response for %s %s in %s, rerun with -record", req.Method, req.URL, r.dir)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var fx fixture
+	if err := json.Unmarshal(dat, &fx); err != nil {
+		return nil, fmt.Errorf("corrupt fixture %s: %w", path, err)
+	}
+
+	body := []byte(fx.Text)
+	if len(fx.Body) > 0 {
+		body = fx.Body
+	}
+
+	header := make(http.Header)
+	for name, value := range fx.Header {
+		header.Set(name, value)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", fx.Status, http.StatusText(fx.Status)),
+		StatusCode:    fx.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func writeFixture(path string, fx fixture) error {
+	dat, err := json.MarshalIndent(fx, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(dat, '\n'), 0644)
+}
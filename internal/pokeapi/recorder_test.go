@@ -0,0 +1,75 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sakuffo/pokedexcli/internal/cache"
+	"github.com/sakuffo/pokedexcli/internal/logger"
+)
+
+// newRecorderClient returns a client with a fresh cache that sends requests for baseURL through rec.
+func newRecorderClient(t *testing.T, baseURL string, rec *Recorder) *Client {
+	testLogger := logger.New(logger.NONE)
+	testCache := cache.NewCache(5*time.Minute, testLogger)
+	t.Cleanup(func() { testCache.Close() })
+
+	return NewClient(
+		WithCache(testCache),
+		WithLogger(testLogger),
+		WithRetryPolicy(RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}),
+		WithBaseURL(baseURL),
+		WithTransport(rec),
+	)
+}
+
+func TestRecorderRecordsAndReplays(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/pokemon/pikachu" {
+			http.Error(w, "Not Found", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": 25, "name": "pikachu", "base_experience": 112}`)
+	})
+	server, _ := setupMockServer(t, handler)
+
+	dir := t.TempDir()
+	recording := newRecorderClient(t, server.URL, NewRecorder(dir, true))
+	if _, err := recording.FetchPokemon(context.Background(), "pikachu"); err != nil {
+		t.Fatalf("Recording FetchPokemon failed: %v", err)
+	}
+	if _, err := recording.FetchPokemon(context.Background(), "missingno"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected ErrNotFound while recording, got %v", err)
+	}
+
+	// Replaying must not touch the server
+	server.Close()
+
+	replaying := newRecorderClient(t, server.URL, NewRecorder(dir, false))
+	pokemon, err := replaying.FetchPokemon(context.Background(), "pikachu")
+	if err != nil {
+		t.Fatalf("Replayed FetchPokemon failed: %v", err)
+	}
+	if pokemon.ID != 25 || pokemon.BaseExperience != 112 {
+		t.Errorf("Unexpected replayed pokemon: %+v", pokemon)
+	}
+
+	if _, err := replaying.FetchPokemon(context.Background(), "missingno"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected replayed 404 to be ErrNotFound, got %v", err)
+	}
+}
+
+func TestRecorderMissingFixture(t *testing.T) {
+	client := newRecorderClient(t, DefaultBaseURL, NewRecorder(t.TempDir(), false))
+
+	_, err := client.FetchPokemon(context.Background(), "pikachu")
+	if err == nil || !strings.Contains(err.Error(), "-record") {
+		t.Errorf("Expected a missing fixture error pointing at -record, got %v", err)
+	}
+}
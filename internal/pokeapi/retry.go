@@ -0,0 +1,149 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how failed GET requests are retried.
+// A zero MaxRetries disables retries entirely.
+type RetryPolicy struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+}
+
+// DefaultRetryPolicy retries a few times with delays suited to an interactive CLI.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	BaseDelay:  250 * time.Millisecond,
+	MaxDelay:   5 * time.Second,
+}
+
+// fetchWithRetry performs the request for url, retrying transient failures
+// with exponential backoff and jitter until the policy is exhausted.
+func (c *Client) fetchWithRetry(ctx context.Context, url string) ([]byte, error) {
+	for attempt := 0; ; attempt++ {
+		dat, err := c.doRequest(ctx, url)
+		if err == nil {
+			return dat, nil
+		}
+
+		if attempt >= c.retry.MaxRetries || !isRetryable(ctx, err) {
+			return nil, err
+		}
+
+		delay := c.retry.backoff(attempt)
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.RetryAfter > 0 {
+			// Waiting longer than MaxDelay would stall the command, so give up instead
+			if httpErr.RetryAfter > c.retry.MaxDelay {
+				c.logger.Info("Not retrying %s: server asked to wait %v", url, httpErr.RetryAfter)
+				return nil, err
+			}
+			delay = httpErr.RetryAfter
+		}
+
+		c.logger.Info("Retrying %s in %v (attempt %d/%d): %v", url, delay, attempt+1, c.retry.MaxRetries, err)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// doRequest performs a single GET request and returns the body of a 2xx response.
+func (c *Client) doRequest(ctx context.Context, url string) ([]byte, error) {
+	if c.limiter != nil {
+		waited, err := c.limiter.wait(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if waited > 0 {
+			c.logger.Info("Rate limit reached, throttled request to %s for %v", url, waited)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.logger.Error("Request to %s failed: %v", url, err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := checkStatus(resp, url); err != nil {
+		c.logger.Error("Request to %s failed: %v", url, err)
+		return nil, err
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// backoff returns the delay before retry number attempt+1: exponential growth
+// capped at MaxDelay, with the upper half randomized to spread out retries.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay << attempt
+	if delay <= 0 || delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// isRetryable reports whether err is a transient failure worth retrying:
+// timeouts, dropped connections, 429 and 5xx responses.
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Status == http.StatusTooManyRequests || httpErr.Status >= 500
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// parseRetryAfter reads a Retry-After header given either as seconds or as an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if when, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(when); delay > 0 {
+			return delay
+		}
+	}
+	return 0
+}
@@ -0,0 +1,124 @@
+package pokeapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryTransientErrors(t *testing.T) {
+	calls := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(Pokemon{ID: 25, Name: "pikachu"})
+	})
+
+	server, client := setupMockServer(t, handler)
+	defer server.Close()
+
+	pokemon, err := client.FetchPokemon(context.Background(), "pikachu")
+	if err != nil {
+		t.Fatalf("Expected success after retries, got %v", err)
+	}
+	if pokemon.Name != "pikachu" {
+		t.Errorf("Unexpected pokemon: %+v", pokemon)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 requests, got %d", calls)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	calls := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "0")
+		http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+	})
+
+	server, client := setupMockServer(t, handler)
+	defer server.Close()
+
+	_, err := client.FetchPokemon(context.Background(), "pikachu")
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("Expected ErrRateLimited, got %v", err)
+	}
+	// One initial attempt plus MaxRetries from setupMockServer
+	if calls != 3 {
+		t.Errorf("Expected 3 requests, got %d", calls)
+	}
+}
+
+func TestRetryAfterBeyondMaxDelay(t *testing.T) {
+	calls := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "3600")
+		http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+	})
+
+	server, client := setupMockServer(t, handler)
+	defer server.Close()
+
+	start := time.Now()
+	_, err := client.FetchPokemon(context.Background(), "pikachu")
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("Expected ErrRateLimited, got %v", err)
+	}
+	if calls != 1 || time.Since(start) > time.Second {
+		t.Errorf("Expected to give up at once instead of waiting an hour, got %d requests in %v", calls, time.Since(start))
+	}
+}
+
+func TestRetryStopsOnCancel(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Bad Gateway", http.StatusBadGateway)
+	})
+
+	server, client := setupMockServer(t, handler, WithRetryPolicy(RetryPolicy{MaxRetries: 5, BaseDelay: time.Hour, MaxDelay: time.Hour}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := client.FetchPokemon(ctx, "pikachu")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected time.Duration
+	}{
+		{input: "", expected: 0},
+		{input: "3", expected: 3 * time.Second},
+		{input: "-1", expected: 0},
+		{input: "soon", expected: 0},
+		{input: "Wed, 21 Oct 2015 07:28:00 GMT", expected: 0}, // in the past
+	}
+
+	for _, c := range cases {
+		if actual := parseRetryAfter(c.input); actual != c.expected {
+			t.Errorf("parseRetryAfter(%q) == %v, expected %v", c.input, actual, c.expected)
+		}
+	}
+}
+
+func TestBackoffBounds(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 10, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	for attempt := 0; attempt < 10; attempt++ {
+		delay := policy.backoff(attempt)
+		if delay < 50*time.Millisecond || delay > time.Second {
+			t.Errorf("backoff(%d) == %v, outside expected bounds", attempt, delay)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package pokeapi
+
+import (
+	"embed"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// snapshotFiles is a small bundled copy of PokeAPI responses used in offline mode.
+// Files are laid out by request path: /pokemon/pikachu is snapshot/pokemon/pikachu.json.
+//
+//go:embed snapshot
+var snapshotFiles embed.FS
+
+// Snapshot returns the bundled PokeAPI responses, rooted at the API base path.
+func Snapshot() fs.FS {
+	sub, err := fs.Sub(snapshotFiles, "snapshot")
+	if err != nil {
+		panic(err) // the directory is embedded at build time
+	}
+	return sub
+}
+
+// SnapshotPath maps a request path and query to its file name in a snapshot.
+// Any query is kept in the name after an "@", so every page of a list gets its own file.
+func SnapshotPath(requestPath, rawQuery string) string {
+	name := strings.Trim(path.Clean("/"+requestPath), "/")
+	if rawQuery != "" {
+		name += "@" + rawQuery
+	}
+	return name + ".json"
+}
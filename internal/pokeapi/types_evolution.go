@@ -0,0 +1,142 @@
+package pokeapi
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EvolutionChain is the family tree of species that evolve into each other,
+// rooted at the first stage.
+type EvolutionChain struct {
+	ID              int               `json:"id"`
+	BabyTriggerItem *NamedAPIResource `json:"baby_trigger_item"`
+	Chain           ChainLink         `json:"chain"`
+}
+
+// ChainLink is one species in an evolution chain. EvolutionDetails says how the
+// previous stage evolves into it and is empty for the first stage.
+type ChainLink struct {
+	IsBaby           bool              `json:"is_baby"`
+	Species          NamedAPIResource  `json:"species"`
+	EvolutionDetails []EvolutionDetail `json:"evolution_details"`
+	EvolvesTo        []ChainLink       `json:"evolves_to"`
+}
+
+// Find returns the link for species anywhere below and including l.
+func (l ChainLink) Find(species string) (ChainLink, bool) {
+	if l.Species.Name == species {
+		return l, true
+	}
+	for _, next := range l.EvolvesTo {
+		if found, ok := next.Find(species); ok {
+			return found, true
+		}
+	}
+	return ChainLink{}, false
+}
+
+// EvolutionDetail is one way of evolving. Only the conditions that apply are set;
+// all of them must be met at once.
+type EvolutionDetail struct {
+	Trigger               NamedAPIResource  `json:"trigger"`
+	Item                  *NamedAPIResource `json:"item"`
+	Gender                *int              `json:"gender"`
+	HeldItem              *NamedAPIResource `json:"held_item"`
+	KnownMove             *NamedAPIResource `json:"known_move"`
+	KnownMoveType         *NamedAPIResource `json:"known_move_type"`
+	Location              *NamedAPIResource `json:"location"`
+	MinLevel              *int              `json:"min_level"`
+	MinHappiness          *int              `json:"min_happiness"`
+	MinBeauty             *int              `json:"min_beauty"`
+	MinAffection          *int              `json:"min_affection"`
+	NeedsOverworldRain    bool              `json:"needs_overworld_rain"`
+	PartySpecies          *NamedAPIResource `json:"party_species"`
+	PartyType             *NamedAPIResource `json:"party_type"`
+	RelativePhysicalStats *int              `json:"relative_physical_stats"`
+	TimeOfDay             string            `json:"time_of_day"`
+	TradeSpecies          *NamedAPIResource `json:"trade_species"`
+	TurnUpsideDown        bool              `json:"turn_upside_down"`
+}
+
+// String describes the detail for players, e.g. "level 16", "use thunder-stone"
+// or "level up with friendship 220 during the day".
+func (d EvolutionDetail) String() string {
+	var trigger string
+	switch d.Trigger.Name {
+	case "level-up":
+		trigger = "level up"
+		if d.MinLevel != nil {
+			trigger = fmt.Sprintf("level %d", *d.MinLevel)
+		}
+	case "use-item":
+		trigger = "use item"
+		if d.Item != nil {
+			trigger = "use " + d.Item.Name
+		}
+	case "trade":
+		trigger = "trade"
+	default:
+		trigger = strings.ReplaceAll(d.Trigger.Name, "-", " ")
+	}
+
+	var conditions []string
+	if d.MinHappiness != nil {
+		conditions = append(conditions, fmt.Sprintf("with friendship %d", *d.MinHappiness))
+	}
+	if d.MinAffection != nil {
+		conditions = append(conditions, fmt.Sprintf("with affection %d", *d.MinAffection))
+	}
+	if d.MinBeauty != nil {
+		conditions = append(conditions, fmt.Sprintf("with beauty %d", *d.MinBeauty))
+	}
+	if d.HeldItem != nil {
+		conditions = append(conditions, "holding "+d.HeldItem.Name)
+	}
+	if d.KnownMove != nil {
+		conditions = append(conditions, "knowing "+d.KnownMove.Name)
+	}
+	if d.KnownMoveType != nil {
+		conditions = append(conditions, "knowing a "+d.KnownMoveType.Name+" move")
+	}
+	if d.TradeSpecies != nil {
+		conditions = append(conditions, "for "+d.TradeSpecies.Name)
+	}
+	if d.PartySpecies != nil {
+		conditions = append(conditions, "with "+d.PartySpecies.Name+" in the party")
+	}
+	if d.PartyType != nil {
+		conditions = append(conditions, "with a "+d.PartyType.Name+" type in the party")
+	}
+	if d.Location != nil {
+		conditions = append(conditions, "at "+d.Location.Name)
+	}
+	if d.Gender != nil {
+		// PokeAPI genders: 1 is female, 2 is male
+		if *d.Gender == 1 {
+			conditions = append(conditions, "if female")
+		} else {
+			conditions = append(conditions, "if male")
+		}
+	}
+	if d.RelativePhysicalStats != nil {
+		switch *d.RelativePhysicalStats {
+		case 1:
+			conditions = append(conditions, "if attack > defense")
+		case -1:
+			conditions = append(conditions, "if attack < defense")
+		default:
+			conditions = append(conditions, "if attack = defense")
+		}
+	}
+	if d.TimeOfDay != "" {
+		conditions = append(conditions, "during the "+d.TimeOfDay)
+	}
+	if d.NeedsOverworldRain {
+		conditions = append(conditions, "in the rain")
+	}
+	if d.TurnUpsideDown {
+		conditions = append(conditions, "holding the console upside down")
+	}
+
+	return strings.Join(append([]string{trigger}, conditions...), " ")
+}
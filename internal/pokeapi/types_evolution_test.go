@@ -0,0 +1,31 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEvolutionDetailString(t *testing.T) {
+	tests := []struct {
+		detail string
+		want   string
+	}{
+		{`{"trigger": {"name": "level-up"}, "min_level": 16}`, "level 16"},
+		{`{"trigger": {"name": "use-item"}, "item": {"name": "thunder-stone"}}`, "use thunder-stone"},
+		{`{"trigger": {"name": "trade"}}`, "trade"},
+		{`{"trigger": {"name": "trade"}, "held_item": {"name": "metal-coat"}}`, "trade holding metal-coat"},
+		{`{"trigger": {"name": "level-up"}, "min_happiness": 220, "time_of_day": "day"}`, "level up with friendship 220 during the day"},
+		{`{"trigger": {"name": "level-up"}, "min_level": 20, "relative_physical_stats": 0}`, "level 20 if attack = defense"},
+		{`{"trigger": {"name": "shed"}}`, "shed"},
+	}
+
+	for _, tt := range tests {
+		var detail EvolutionDetail
+		if err := json.Unmarshal([]byte(tt.detail), &detail); err != nil {
+			t.Fatalf("Invalid test JSON %s: %v", tt.detail, err)
+		}
+		if got := detail.String(); got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.detail, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package pokeapi
+
+// MaxLevel is the highest level a Pokemon can reach.
+const MaxLevel = 100
+
+// GrowthRate is how much experience Pokemon of a species need for each level.
+type GrowthRate struct {
+	ID             int                         `json:"id"`
+	Name           string                      `json:"name"`
+	Formula        string                      `json:"formula"`
+	Levels         []GrowthRateExperienceLevel `json:"levels"`
+	PokemonSpecies []NamedAPIResource          `json:"pokemon_species"`
+}
+
+// GrowthRateExperienceLevel is the total experience needed to reach a level.
+type GrowthRateExperienceLevel struct {
+	Level      int `json:"level"`
+	Experience int `json:"experience"`
+}
+
+// ExperienceFor returns the total experience needed to reach level.
+func (g GrowthRate) ExperienceFor(level int) int {
+	for _, l := range g.Levels {
+		if l.Level == level {
+			return l.Experience
+		}
+	}
+	return 0
+}
+
+// LevelFor returns the level reached with experience, never more than MaxLevel.
+func (g GrowthRate) LevelFor(experience int) int {
+	level := 1
+	for _, l := range g.Levels {
+		if l.Experience <= experience && l.Level > level {
+			level = l.Level
+		}
+	}
+	return min(level, MaxLevel)
+}
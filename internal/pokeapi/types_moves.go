@@ -0,0 +1,90 @@
+package pokeapi
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Type is an elemental type such as "electric", with how it fares against the others.
+type Type struct {
+	ID              int               `json:"id"`
+	Name            string            `json:"name"`
+	DamageRelations TypeRelations     `json:"damage_relations"`
+	Generation      NamedAPIResource  `json:"generation"`
+	MoveDamageClass *NamedAPIResource `json:"move_damage_class"`
+	Pokemon         []struct {
+		Slot    int              `json:"slot"`
+		Pokemon NamedAPIResource `json:"pokemon"`
+	} `json:"pokemon"`
+	Moves []NamedAPIResource `json:"moves"`
+}
+
+// TypeRelations lists the types a type deals and takes no, half or double damage against.
+type TypeRelations struct {
+	NoDamageTo       []NamedAPIResource `json:"no_damage_to"`
+	HalfDamageTo     []NamedAPIResource `json:"half_damage_to"`
+	DoubleDamageTo   []NamedAPIResource `json:"double_damage_to"`
+	NoDamageFrom     []NamedAPIResource `json:"no_damage_from"`
+	HalfDamageFrom   []NamedAPIResource `json:"half_damage_from"`
+	DoubleDamageFrom []NamedAPIResource `json:"double_damage_from"`
+}
+
+// Move is an attack or status move. Power, accuracy and PP are nil for moves
+// that don't have them, such as status moves that never miss.
+type Move struct {
+	ID            int              `json:"id"`
+	Name          string           `json:"name"`
+	Accuracy      *int             `json:"accuracy"`
+	EffectChance  *int             `json:"effect_chance"`
+	PP            *int             `json:"pp"`
+	Priority      int              `json:"priority"`
+	Power         *int             `json:"power"`
+	DamageClass   NamedAPIResource `json:"damage_class"`
+	Type          NamedAPIResource `json:"type"`
+	Generation    NamedAPIResource `json:"generation"`
+	EffectEntries []VerboseEffect  `json:"effect_entries"`
+}
+
+// Ability is a passive effect a Pokemon can have.
+type Ability struct {
+	ID            int              `json:"id"`
+	Name          string           `json:"name"`
+	IsMainSeries  bool             `json:"is_main_series"`
+	Generation    NamedAPIResource `json:"generation"`
+	EffectEntries []VerboseEffect  `json:"effect_entries"`
+	Pokemon       []struct {
+		IsHidden bool             `json:"is_hidden"`
+		Slot     int              `json:"slot"`
+		Pokemon  NamedAPIResource `json:"pokemon"`
+	} `json:"pokemon"`
+}
+
+// VerboseEffect describes an effect in one language.
+type VerboseEffect struct {
+	Effect      string           `json:"effect"`
+	ShortEffect string           `json:"short_effect"`
+	Language    NamedAPIResource `json:"language"`
+}
+
+// EnglishEffect returns the English entry of entries, if there is one.
+func EnglishEffect(entries []VerboseEffect) (VerboseEffect, bool) {
+	for _, entry := range entries {
+		if entry.Language.Name == "en" {
+			return entry, true
+		}
+	}
+	return VerboseEffect{}, false
+}
+
+// ShortEffect returns the move's English short effect with the effect chance
+// filled in, or an empty string if PokeAPI has no description.
+func (m Move) ShortEffect() string {
+	entry, ok := EnglishEffect(m.EffectEntries)
+	if !ok {
+		return ""
+	}
+	if m.EffectChance == nil {
+		return entry.ShortEffect
+	}
+	return strings.ReplaceAll(entry.ShortEffect, "$effect_chance", strconv.Itoa(*m.EffectChance))
+}
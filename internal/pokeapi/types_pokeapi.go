@@ -1,15 +1,39 @@
 package pokeapi
 
-type Locations struct {
-	Count    int     `json:"count"`
-	Next     *string `json:"next"`
-	Previous *string `json:"previous"`
-	Results  []struct {
-		Name string `json:"name"`
-		URL  string `json:"url"`
-	} `json:"results"`
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// NamedAPIResource is a link to another resource, as found in list endpoints.
+type NamedAPIResource struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// APIResource is a link to a resource that has no name, such as an evolution chain.
+type APIResource struct {
+	URL string `json:"url"`
+}
+
+// ID returns the numeric id at the end of the resource's URL.
+func (r APIResource) ID() (int, error) {
+	return resourceID(r.URL)
 }
 
+// NamedAPIResourceList is one page of a list endpoint such as /location-area or /pokemon.
+type NamedAPIResourceList struct {
+	Count    int                `json:"count"`
+	Next     *string            `json:"next"`
+	Previous *string            `json:"previous"`
+	Results  []NamedAPIResource `json:"results"`
+}
+
+// Locations is a page of /location-area.
+type Locations = NamedAPIResourceList
+
 type Area struct {
 	Location struct {
 		Name string `json:"name"`
@@ -21,3 +45,13 @@ type Area struct {
 		} `json:"pokemon"`
 	} `json:"pokemon_encounters"`
 }
+
+// resourceID parses the id from a resource URL such as
+// "https://pokeapi.co/api/v2/evolution-chain/10/".
+func resourceID(url string) (int, error) {
+	id, err := strconv.Atoi(path.Base(strings.TrimSuffix(url, "/")))
+	if err != nil {
+		return 0, fmt.Errorf("no resource id in %q", url)
+	}
+	return id, nil
+}
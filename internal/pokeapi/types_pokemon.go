@@ -1,8 +1,37 @@
 package pokeapi
 
 type PokemonSpecies struct {
-	Name        string `json:"name"`
-	CaptureRate int    `json:"capture_rate"`
+	ID                 int                     `json:"id"`
+	Name               string                  `json:"name"`
+	Order              int                     `json:"order"`
+	CaptureRate        int                     `json:"capture_rate"`
+	BaseHappiness      int                     `json:"base_happiness"`
+	IsBaby             bool                    `json:"is_baby"`
+	IsLegendary        bool                    `json:"is_legendary"`
+	IsMythical         bool                    `json:"is_mythical"`
+	GrowthRate         NamedAPIResource        `json:"growth_rate"`
+	Generation         NamedAPIResource        `json:"generation"`
+	EvolutionChain     APIResource             `json:"evolution_chain"`
+	EvolvesFromSpecies *NamedAPIResource       `json:"evolves_from_species"` // nil for the first stage
+	Varieties          []PokemonSpeciesVariety `json:"varieties"`
+}
+
+// PokemonSpeciesVariety is one of the Pokemon, i.e. forms, that belong to a species.
+type PokemonSpeciesVariety struct {
+	IsDefault bool             `json:"is_default"`
+	Pokemon   NamedAPIResource `json:"pokemon"`
+}
+
+// DefaultPokemon returns the name of the species' default Pokemon. It often
+// matches the species name but not always, e.g. toxtricity's is toxtricity-amped.
+// Without varieties the species name is the best guess.
+func (s PokemonSpecies) DefaultPokemon() string {
+	for _, v := range s.Varieties {
+		if v.IsDefault {
+			return v.Pokemon.Name
+		}
+	}
+	return s.Name
 }
 
 type Pokemon struct {
@@ -48,3 +77,12 @@ type Pokemon struct {
 		} `json:"type"`
 	} `json:"types"`
 }
+
+// Nature raises one stat by 10% and lowers another by 10%. Both are nil for
+// neutral natures such as "hardy".
+type Nature struct {
+	ID            int               `json:"id"`
+	Name          string            `json:"name"`
+	IncreasedStat *NamedAPIResource `json:"increased_stat"`
+	DecreasedStat *NamedAPIResource `json:"decreased_stat"`
+}
@@ -2,36 +2,51 @@ package repl
 
 import (
 	"bufio"
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
 	"strings"
 
-	// "github.com/sakuffo/pokedexcli/internal/pokedata" // <-- Remove old import
-	"github.com/sakuffo/pokedexcli/internal/commands" // Assuming commands package exists/is correct
-	"github.com/sakuffo/pokedexcli/internal/config"   // <-- Add new import
+	"github.com/sakuffo/pokedexcli/internal/commands"
+	"github.com/sakuffo/pokedexcli/internal/config"
 )
 
 // StartRepl starts the Read-Eval-Print Loop for the Pokedex CLI.
-func StartRepl(cfg *config.Config) { // <-- Updated type
-	scanner := bufio.NewScanner(os.Stdin)
-	cmds := commands.GetCommands() // Fetch commands (assuming this function exists and is correct)
+// Ctrl-C while a command is running cancels that command and returns to the prompt;
+// Ctrl-C at the prompt ends the REPL so the caller can save and exit.
+func StartRepl(ctx context.Context, cfg *config.Config) {
+	cmds := commands.GetCommands()
 
-	cfg.Logger.Info("Starting REPL...") // Use logger from config
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
+
+	lines := readLines(bufio.NewScanner(os.Stdin), cfg)
+
+	cfg.Logger.Info("Starting REPL...")
 
 	for {
 		fmt.Print("Pokedex > ")
-		scanned := scanner.Scan()
-		if !scanned {
-			// Handle EOF or scanner error
-			if err := scanner.Err(); err != nil {
-				cfg.Logger.Error("Scanner error: %v", err)
-			} else {
-				cfg.Logger.Info("EOF received, exiting REPL.")
+
+		var text string
+		select {
+		case <-ctx.Done():
+			cfg.Logger.Info("Context cancelled, exiting REPL.")
+			return
+		case <-interrupts:
+			fmt.Println()
+			cfg.Logger.Info("Interrupt received at prompt, exiting REPL.")
+			return
+		case line, ok := <-lines:
+			if !ok {
+				cfg.Logger.Info("Input closed, exiting REPL.")
+				return
 			}
-			break // Exit loop on EOF or error
+			text = line
 		}
 
-		text := scanner.Text()
 		cleaned := cleanInput(text)
 		if len(cleaned) == 0 {
 			continue // Skip empty input
@@ -50,26 +65,20 @@ func StartRepl(cfg *config.Config) { // <-- Updated type
 			continue
 		}
 
-		// Execute the command
-		// Command callbacks should ideally return an error for central handling
-		err := command.Callback(cfg, args...) // Pass config.Config
+		err := runCommand(ctx, cfg, command.Callback, interrupts, args)
+		if errors.Is(err, context.Canceled) {
+			cfg.Logger.Info("Command '%s' cancelled", commandName)
+			fmt.Println("\nCommand cancelled.")
+			continue
+		}
 		if err != nil {
 			// Log the error centrally and inform the user
 			cfg.Logger.Error("Command '%s' failed: %v", commandName, err)
 			fmt.Printf("Error executing command: %v\n", err)
-
-			// Specific handling for exit command error? Unlikely needed if exit logic is simple.
-			if commandName == "exit" {
-				// If exit itself fails (e.g., during save triggered by exit),
-				// we might already be logging it. Decide if loop should break anyway.
-				fmt.Println("Exit command encountered an error, but proceeding to exit.")
-				break // Ensure exit happens even if save fails
-			}
 		}
 
 		// Check if the command was 'exit' to break the loop
-		// (Error handling above might also break)
-		if commandName == "exit" && err == nil { // Only break if exit command succeeded
+		if commandName == "exit" {
 			cfg.Logger.Info("Exit command received, terminating REPL.")
 			break
 		}
@@ -79,13 +88,46 @@ func StartRepl(cfg *config.Config) { // <-- Updated type
 	// Saving is handled in main.go after the REPL exits
 }
 
+// runCommand executes callback with a context that is cancelled if an interrupt
+// arrives before the command finishes.
+func runCommand(parent context.Context, cfg *config.Config, callback func(context.Context, *config.Config, ...string) error, interrupts <-chan os.Signal, args []string) error {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-interrupts:
+			cfg.Logger.Debug("Interrupt received, cancelling running command")
+			cancel()
+		case <-done:
+		}
+	}()
+
+	return callback(ctx, cfg, args...)
+}
+
+// readLines feeds scanned lines into a channel so the REPL can wait on input
+// and interrupts at the same time. The channel is closed on EOF or scanner error.
+func readLines(scanner *bufio.Scanner, cfg *config.Config) <-chan string {
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		if err := scanner.Err(); err != nil {
+			cfg.Logger.Error("Scanner error: %v", err)
+		}
+	}()
+	return lines
+}
+
 // cleanInput splits the input string by spaces and trims each part.
 func cleanInput(str string) []string {
 	lowered := strings.ToLower(str)
 	words := strings.Fields(lowered) // Splits by whitespace and removes empty strings
 	return words
 }
-
-// Note: Assumes a package `internal/commands` exists with:
-// type cliCommand struct { Name string; Description string; Callback func(cfg *config.Config, args ...string) error }
-// func GetCommands() map[string]cliCommand
@@ -0,0 +1,126 @@
+// Package search ranks resource names against loosely typed queries, so users
+// can find "viridian-forest-area" by typing "viridian forest" or "virdian".
+package search
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// Match is a candidate that matched a query. Higher scores are better matches.
+type Match struct {
+	Name  string
+	Score int
+}
+
+// Scores for each kind of match; the kinds never overlap so rankings stay predictable.
+const (
+	exactScore       = 1000
+	substringScore   = 500
+	wordStartBonus   = 100
+	subsequenceScore = 300
+	typoScore        = 100
+)
+
+// Rank returns the candidates that match query, best first. Ties go to the
+// shorter name, then alphabetically. Queries match case-insensitively and
+// spaces count as the dashes PokeAPI uses in names.
+func Rank(query string, candidates []string) []Match {
+	q := normalize(query)
+	if q == "" {
+		return nil
+	}
+
+	var matches []Match
+	for _, name := range candidates {
+		if score, ok := score(q, normalize(name)); ok {
+			matches = append(matches, Match{Name: name, Score: score})
+		}
+	}
+
+	slices.SortFunc(matches, func(a, b Match) int {
+		return cmp.Or(
+			cmp.Compare(b.Score, a.Score),
+			cmp.Compare(len(a.Name), len(b.Name)),
+			strings.Compare(a.Name, b.Name),
+		)
+	})
+	return matches
+}
+
+func normalize(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(strings.ReplaceAll(s, "-", " "))), "-")
+}
+
+// score rates how well name matches q, reporting false if it doesn't match at all.
+func score(q, name string) (int, bool) {
+	if q == name {
+		return exactScore, true
+	}
+
+	if i := strings.Index(name, q); i >= 0 {
+		s := substringScore - min(i, substringScore/2)
+		if i == 0 || name[i-1] == '-' {
+			s += wordStartBonus
+		}
+		return s, true
+	}
+
+	if gaps, ok := subsequence(q, name); ok {
+		return subsequenceScore - min(gaps*10, subsequenceScore/2), true
+	}
+
+	// Allow roughly one typo for every four letters, against any single word of the name
+	allowed := max(1, len(q)/4)
+	best := allowed + 1
+	for _, word := range strings.Split(name, "-") {
+		best = min(best, distance(q, word))
+	}
+	if best <= allowed {
+		return typoScore - best*20, true
+	}
+
+	return 0, false
+}
+
+// subsequence reports whether every byte of q appears in name in order,
+// and how many bytes of name were skipped between the first and last match.
+func subsequence(q, name string) (gaps int, ok bool) {
+	qi, start := 0, -1
+	for i := 0; i < len(name) && qi < len(q); i++ {
+		if name[i] != q[qi] {
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+		qi++
+		if qi == len(q) {
+			return i - start + 1 - len(q), true
+		}
+	}
+	return 0, false
+}
+
+// distance is the Levenshtein edit distance between a and b.
+func distance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,75 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+var locations = []string{
+	"viridian-city-area",
+	"viridian-forest-area",
+	"pewter-city-area",
+	"route-2-south-towards-viridian-city",
+	"cerulean-city-area",
+	"eterna-forest-area",
+}
+
+func names(matches []Match) []string {
+	var out []string
+	for _, m := range matches {
+		out = append(out, m.Name)
+	}
+	return out
+}
+
+func TestRank(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		// Word starts beat matches in the middle, then shorter names win
+		{"viridian", []string{"viridian-city-area", "viridian-forest-area", "route-2-south-towards-viridian-city"}},
+		{"Viridian Forest", []string{"viridian-forest-area"}},
+		{"forest", []string{"eterna-forest-area", "viridian-forest-area"}},
+		// Letters in order, with gaps
+		{"vrdnfrst", []string{"viridian-forest-area"}},
+		// A typo in a single word
+		{"virdian", []string{"viridian-city-area", "viridian-forest-area", "route-2-south-towards-viridian-city"}},
+		{"cerulaen", []string{"cerulean-city-area"}},
+		{"cerulean-city-area", []string{"cerulean-city-area"}},
+		{"lavender", nil},
+		{"  ", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			if got := names(Rank(tt.query, locations)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Rank(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRankPrefersExactMatches(t *testing.T) {
+	matches := Rank("pewter-city-area", locations)
+	if len(matches) == 0 || matches[0].Name != "pewter-city-area" || matches[0].Score != exactScore {
+		t.Errorf("Expected the exact match first, got %+v", matches)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"virdian", "viridian", 1},
+		{"same", "same", 0},
+	}
+	for _, tt := range tests {
+		if got := distance(tt.a, tt.b); got != tt.want {
+			t.Errorf("distance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
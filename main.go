@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
@@ -10,39 +11,87 @@ import (
 	"github.com/sakuffo/pokedexcli/internal/app"
 	"github.com/sakuffo/pokedexcli/internal/config"
 	"github.com/sakuffo/pokedexcli/internal/logger"
+	"github.com/sakuffo/pokedexcli/internal/pokeapi"
 	"github.com/sakuffo/pokedexcli/internal/repl"
 )
 
 func main() {
-	// Parse command-line flags for log level
+	// Parse command-line flags
+	configPath := flag.String("config", defaultConfigFile, "JSON config file; flags given on the command line override it")
 	logLevelStr := flag.String("loglevel", "NONE", "Set log level (DEBUG, INFO, ERROR, FATAL, NONE)")
+	cacheDir := flag.String("cache-dir", "", "Directory for the on-disk HTTP cache (defaults to the data directory)")
+	offline := flag.Bool("offline", false, "Serve data only from the on-disk cache and bundled snapshot, without network access")
+	apiBaseURL := flag.String("api-base-url", pokeapi.DefaultBaseURL, "PokeAPI root URL, e.g. http://localhost:8000/api/v2 for cmd/fakepokeapi")
+	timeout := flag.Duration("timeout", pokeapi.DefaultTimeout, "Timeout for each PokeAPI request")
+	userAgent := flag.String("user-agent", pokeapi.DefaultUserAgent, "User-Agent header sent to PokeAPI")
+	rateLimit := flag.Float64("rate-limit", pokeapi.DefaultRateLimit, "Maximum PokeAPI requests per second (0 disables limiting)")
+	rateBurst := flag.Int("rate-burst", pokeapi.DefaultRateBurst, "Number of PokeAPI requests that may be sent back to back")
+	useDataset := flag.Bool("dataset", false, "Serve data from a dump loaded with 'import-dataset' instead of PokeAPI")
+	flag.Usage = usage
 	flag.Parse()
 
-	// Convert string log level to logger.LogLevel
-	level := parseLogLevel(*logLevelStr)
+	opts := app.Options{
+		LogLevel:   parseLogLevel(*logLevelStr),
+		CacheDir:   *cacheDir,
+		Offline:    *offline,
+		APIBaseURL: *apiBaseURL,
+		Timeout:    *timeout,
+		UserAgent:  *userAgent,
+		RateLimit:  *rateLimit,
+		RateBurst:  *rateBurst,
+		Dataset:    *useDataset,
+	}
+	if err := applyConfigFile(&opts, *configPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Subcommands run once and exit instead of starting the REPL
+	if flag.Arg(0) == "import-dataset" {
+		if flag.NArg() != 2 {
+			usage()
+			os.Exit(2)
+		}
+		if err := app.ImportDataset(flag.Arg(1), opts.LogLevel); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to import dataset: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
 
 	// Initialize the application
-	cfg, err := app.Initialize(level)
+	cfg, err := app.Initialize(opts)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to initialize application: %v\n", err)
 		os.Exit(1)
 	}
 
+	if opts.Offline {
+		fmt.Println("Offline mode: only cached and bundled data is available.")
+	}
+
 	// Set up signal handling
 	setupSignalHandling(cfg)
 
 	// Start REPL
-	repl.StartRepl(cfg)
-
-	// Final save on exit
-	cfg.Logger.Info("REPL exited normally. Performing final save...")
-	if err := app.SaveData(cfg); err != nil {
-		cfg.Logger.Error("Failed to perform final save on exit: %v", err)
-	} else {
-		cfg.Logger.Info("Final save completed.")
+	repl.StartRepl(context.Background(), cfg)
+
+	// Final save and cleanup on exit
+	cfg.Logger.Info("REPL exited normally. Shutting down...")
+	if err := app.Shutdown(cfg); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to shut down cleanly: %v\n", err)
+		os.Exit(1)
 	}
+}
 
-	cfg.Logger.Info("Exiting Pokedex CLI.")
+// usage prints how to run the CLI, including its subcommands
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintf(out, "Usage:\n")
+	fmt.Fprintf(out, "  %s [flags]                         start the Pokedex REPL\n", os.Args[0])
+	fmt.Fprintf(out, "  %s [flags] import-dataset <dir>    import a PokeAPI CSV data dump for use with -dataset\n", os.Args[0])
+	fmt.Fprintf(out, "\nFlags:\n")
+	flag.PrintDefaults()
 }
 
 // parseLogLevel converts a string log level to logger.LogLevel
@@ -64,21 +113,21 @@ func parseLogLevel(levelStr string) logger.LogLevel {
 	}
 }
 
-// setupSignalHandling sets up signal handling for graceful shutdown
+// setupSignalHandling sets up signal handling for graceful shutdown.
+// Interrupts (Ctrl-C) are handled by the REPL, which cancels the running command.
 func setupSignalHandling(cfg *config.Config) {
 	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	signal.Notify(c, syscall.SIGTERM)
 
 	go func() {
 		sig := <-c
-		cfg.Logger.Info("Received signal: %v. Saving data before exiting...", sig)
+		cfg.Logger.Info("Received signal: %v. Shutting down before exiting...", sig)
 
-		if err := app.SaveData(cfg); err != nil {
-			cfg.Logger.Error("Failed to save data during signal handling: %v", err)
+		if err := app.Shutdown(cfg); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to shut down cleanly: %v\n", err)
 			os.Exit(1)
 		}
 
-		cfg.Logger.Info("Data saved successfully. Exiting now.")
 		os.Exit(0)
 	}()
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -87,7 +88,7 @@ func startRepl(cfg *config.Config) {
 
 		command, exists := commands.GetCommands()[commandName]
 		if exists {
-			err := command.Callback(cfg, args...)
+			err := command.Callback(context.Background(), cfg, args...)
 			if err != nil {
 				cfg.Logger.Error("Error executing command: '%s': %v", commandName, err)
 				fmt.Println(err)